@@ -1,3 +1,5 @@
+import sys
+
 def process_input(input_text):
     lines = input_text.strip().split('\n')
     result = []
@@ -29,24 +31,8 @@ def find_max_sum_index(arrays):
     return max_sum_index, max_sum
 
 if __name__ == "__main__":
-    input_text = """1000
-    2000
-    3000
-
-    4000
-
-    5000
-    6000
-
-    7000
-    8000
-    9000
-
-    10000"""
-
-    arrays = process_input(input_text)
+    arrays = process_input(sys.stdin.read())
     max_sum_index, max_sum = find_max_sum_index(arrays)
 
-    print("List of Lists:", arrays)
-    print("Index with the Highest Sum:", max_sum_index, " with Sum:", max_sum)
-
+    print("Part 1:", max_sum)
+    print("  Index with the Highest Sum:", max_sum_index)
@@ -0,0 +1,20 @@
+// Package day01 runs the 2023 day 1 Python solution, main.py, through the
+// same runner as the Go days. The script is embedded, so the aoc binary
+// only needs python3 on the PATH.
+package day01
+
+import (
+	"embed"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+//go:embed main.py
+var script string
+
+//go:embed samples
+var samples embed.FS
+
+func init() {
+	aoc.Register(2023, 1, aoc.External("python3", "-c", script), samples)
+}
@@ -1,3 +1,5 @@
+import sys
+
 def process_input(input_text):
     lines = input_text.strip().split('\n')
     result = []
@@ -26,25 +28,8 @@ def find_top_3_indices(arrays):
     return top_3_indices
 
 if __name__ == "__main__":
-    input_text = """1000
-    2000
-    3000
-
-    4000
-
-    5000
-    6000
-
-    7000
-    8000
-    9000
-
-    10000"""
-
-    arrays = process_input(input_text)
+    arrays = process_input(sys.stdin.read())
     top_3_indices = find_top_3_indices(arrays)
 
-    print("List of Lists:", arrays)
-    print("Top 3 Indices with the Highest Sum:", top_3_indices)
-    print("Top 3 Indices total sum:", sum(item[1] for item in top_3_indices))
-
+    print("Part 1:", sum(item[1] for item in top_3_indices))
+    print("  Top 3 Indices with the Highest Sum:", top_3_indices)
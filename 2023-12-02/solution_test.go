@@ -0,0 +1,18 @@
+package day02
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	if _, err := exec.LookPath("python3"); err != nil {
+		t.Skip("python3 not found")
+	}
+	aoctest.Run(t, aoc.External("python3", "-c", script), []aoctest.Case{
+		{Name: "example", Part1: "45000"},
+	})
+}
@@ -0,0 +1,32 @@
+package day01
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 1, aoc.Generator{Generate: generate, Size: 4500, Unit: "rotations"})
+}
+
+// generate writes size rotations of 1-999 clicks; about one in ten is
+// longer than a full turn, like the real input.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	for range size {
+		direction := "L"
+		if rng.IntN(2) == 0 {
+			direction = "R"
+		}
+		distance := 1 + rng.IntN(dialSize-1)
+		if rng.IntN(10) == 0 {
+			distance = dialSize + rng.IntN(9*dialSize)
+		}
+		if _, err := fmt.Fprintf(w, "%s%d\n", direction, distance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/*
+start with 50, 0-99
+passwaord -> number of times point to 0
+L for minus, R for plus
+if goes below 0, wrap to 99
+if goes above 99, wrap to 0
+
+use input.txt get final count
+*/
+package day01
+
+import (
+	"context"
+	"embed"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/dial"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+const dialSize = 100
+const startPosition = 50
+
+type rotation struct {
+	direction dial.Direction
+	distance  int
+}
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	rotations []rotation
+	lines     []int // input line of each rotation
+}
+
+func init() {
+	aoc.Register(2025, 1, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		rot, ok, err := parseRotation(sc)
+		if err != nil {
+			return err
+		}
+		if ok {
+			s.rotations = append(s.rotations, rot)
+			s.lines = append(s.lines, sc.Line())
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if len(s.rotations) == 0 {
+		return sc.Missing("a rotation")
+	}
+	return nil
+}
+
+// parseRotation reads the rotation on the scanner's current line. ok is
+// false for a blank line, and for a bad one a lenient parse skips.
+func parseRotation(sc *parse.Scanner) (rot rotation, ok bool, err error) {
+	line := sc.Text()
+	if strings.TrimSpace(line) == "" {
+		return rot, false, nil
+	}
+
+	if line[0] != 'L' && line[0] != 'R' {
+		return rot, false, sc.Bad(1, "direction L or R", line[:1])
+	}
+	distance, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil || distance < 0 {
+		return rot, false, sc.Bad(2, "distance", line[1:])
+	}
+	return rotation{dial.Direction(line[0]), distance}, true, nil
+}
+
+// Stream turns the dial as rotations arrive, reporting both passwords so
+// far after each one, so a log too long to hold, or still being written,
+// can be watched.
+func (s *solver) Stream(ctx context.Context, r io.Reader, update func(part1, part2 aoc.Answer)) error {
+	d := dial.New(dialSize, startPosition, 0)
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+		rot, ok, err := parseRotation(sc)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		d.Turn(rot.direction, rot.distance)
+		zero := d.Count(0)
+		update(aoc.Int(zero.Lands), aoc.Int(zero.Hits()))
+	}
+	return sc.Err()
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	endOnZero, _ := simulate(s.rotations)
+	return aoc.Int(endOnZero), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	endOnZero, passZero := simulate(s.rotations)
+	return aoc.Int(passZero + endOnZero), nil
+}
+
+// simulate runs every rotation and returns how many rotations ended on zero
+// and how many times the dial passed zero mid-rotation.
+func simulate(rotations []rotation) (endOnZero, passZero int) {
+	d := dial.New(dialSize, startPosition, 0)
+	d.Apply(turns(rotations)...)
+	zero := d.Count(0)
+	return zero.Lands, zero.Passes
+}
+
+func turns(rotations []rotation) []dial.Turn {
+	turns := make([]dial.Turn, len(rotations))
+	for i, rot := range rotations {
+		turns[i] = dial.Turn{Dir: rot.direction, Clicks: rot.distance}
+	}
+	return turns
+}
@@ -0,0 +1,155 @@
+package day01
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "3", Part2: "6"},
+	})
+}
+
+func TestSimulate(t *testing.T) {
+	tests := []struct {
+		name                string
+		rotations           []rotation
+		endOnZero, passZero int
+	}{
+		{"no rotations", nil, 0, 0},
+		{"land on zero", []rotation{{'L', 50}}, 1, 0},
+		{"pass zero going left", []rotation{{'L', 60}}, 0, 1},
+		{"pass zero going right", []rotation{{'R', 60}}, 0, 1},
+		{"full turns", []rotation{{'R', 1000}}, 0, 10},
+		{"full turns ending on zero", []rotation{{'R', 250}}, 1, 2},
+		{"leave zero without passing it", []rotation{{'L', 50}, {'R', 99}}, 1, 0},
+		{"full turn from zero", []rotation{{'L', 50}, {'L', 100}}, 2, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endOnZero, passZero := simulate(tt.rotations)
+			if endOnZero != tt.endOnZero || passZero != tt.passZero {
+				t.Errorf("simulate = (%d, %d), want (%d, %d)", endOnZero, passZero, tt.endOnZero, tt.passZero)
+			}
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad direction", Input: "L68\nX30\n", Err: `line 2:1: expected direction L or R, got "X"`},
+		{Name: "bad distance", Input: "L68\nR3O\n", Err: `line 2:2: expected distance, got "3O"`},
+		{Name: "missing distance", Input: "L68\nR\n", Err: `line 2:2: expected distance, got end of line`},
+		{Name: "empty", Input: "\n", Err: `line 2: expected a rotation, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 200)
+}
+
+func TestTraceReplay(t *testing.T) {
+	f, err := os.Open("samples/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := &solver{}
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range []string{"text", "csv"} {
+		t.Run(format, func(t *testing.T) {
+			var trace bytes.Buffer
+			if err := s.Trace(&trace, format); err != nil {
+				t.Fatal(err)
+			}
+			part1, part2, err := (&solver{}).Replay(&trace)
+			if err != nil {
+				t.Fatalf("Replay: %v", err)
+			}
+			if part1.Value != "3" || part2.Value != "6" {
+				t.Errorf("Replay = %s, %s, want 3, 6", part1, part2)
+			}
+			if notes := append(part1.Diagnostics, part2.Diagnostics...); !slices.Equal(notes, []string{"10 steps"}) {
+				t.Errorf("Replay diagnostics = %q, want only the step count", notes)
+			}
+		})
+	}
+}
+
+func TestReplayChecksSteps(t *testing.T) {
+	trace := `line,start,direction,distance,end,zero_crossings
+1,50,L,68,82,1
+2,82,L,30,52,2
+3,50,R,48,0,1
+`
+	part1, part2, err := (&solver{}).Replay(strings.NewReader(trace))
+	if !errors.Is(err, aoc.ErrTraceMismatch) || err.Error() != "2 of 3 steps do not follow the dial: aoc: trace does not match the solver" {
+		t.Errorf("Replay error = %v, want 2 of 3 steps not following", err)
+	}
+	if part1.Value != "1" || part2.Value != "4" {
+		t.Errorf("Replay = %s, %s, want the trace's own totals 1, 4", part1, part2)
+	}
+	want1 := []string{"3 steps", "line 3: starts at 50, but the step before ended at 52", "line 3: R48 from 50: trace ends at 0, the dial at 98"}
+	if !slices.Equal(part1.Diagnostics, want1) {
+		t.Errorf("part 1 diagnostics = %q, want %q", part1.Diagnostics, want1)
+	}
+	want2 := []string{"line 2: L30 from 82: trace crosses zero 2 times, the dial 0", "line 3: R48 from 50: trace crosses zero 1 times, the dial 0"}
+	if !slices.Equal(part2.Diagnostics, want2) {
+		t.Errorf("part 2 diagnostics = %q, want %q", part2.Diagnostics, want2)
+	}
+}
+
+func TestReplayMalformed(t *testing.T) {
+	tests := []struct {
+		name, trace, err string
+	}{
+		{"no header", "", "line 1: expected a trace header, got end of input"},
+		{"bad header", "L68\n", `line 1:1: expected a text or CSV trace header, got "L68"`},
+		{"bad step", "LINE START TURN END ZEROS\n1 50 X68 82 1\n", `line 2:1: expected line, start, direction, distance, end and zero crossings, got "1 50 X68 82 1"`},
+		{"off the dial", "LINE START TURN END ZEROS\n1 50 L68 182 1\n", `line 2:1: expected line, start, direction, distance, end and zero crossings, got "1 50 L68 182 1"`},
+		{"no steps", "LINE START TURN END ZEROS\n", "line 2: expected a trace step, got end of input"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := (&solver{}).Replay(strings.NewReader(tt.trace))
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("Replay error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	f, err := os.Open("samples/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var part1, part2 []string
+	err = (&solver{}).Stream(t.Context(), f, func(a1, a2 aoc.Answer) {
+		part1 = append(part1, a1.Value)
+		part2 = append(part2, a2.Value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"0", "0", "1", "1", "1", "2", "2", "3", "3", "3"}; !slices.Equal(part1, want) {
+		t.Errorf("running Part 1 = %v, want %v", part1, want)
+	}
+	if want := []string{"1", "1", "2", "2", "3", "4", "4", "5", "5", "6"}; !slices.Equal(part2, want) {
+		t.Errorf("running Part 2 = %v, want %v", part2, want)
+	}
+}
@@ -0,0 +1,184 @@
+package day01
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/dial"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// step is one rotation as the dial took it. zeros counts every click that
+// left the dial on 0, the rotation's last included, so Part 2 is the sum of
+// zeros and Part 1 the number of steps ending on 0.
+type step struct {
+	line      int
+	start     int
+	direction dial.Direction
+	distance  int
+	end       int
+	zeros     int
+}
+
+// turn takes one rotation from start on a fresh dial.
+func turn(line, start int, rot rotation) step {
+	d := dial.New(dialSize, start, 0)
+	d.Turn(rot.direction, rot.distance)
+	return step{line, start, rot.direction, rot.distance, d.Position(), d.Count(0).Hits()}
+}
+
+// steps replays the parsed rotations from the start position.
+func (s *solver) steps() []step {
+	steps := make([]step, len(s.rotations))
+	position := startPosition
+	for i, rot := range s.rotations {
+		steps[i] = turn(s.lines[i], position, rot)
+		position = steps[i].end
+	}
+	return steps
+}
+
+var (
+	textHeader = []string{"LINE", "START", "TURN", "END", "ZEROS"}
+	csvHeader  = []string{"line", "start", "direction", "distance", "end", "zero_crossings"}
+)
+
+func (s *solver) Trace(w io.Writer, format string) error {
+	steps := s.steps()
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		fmt.Fprintln(tw, strings.Join(textHeader, "\t")+"\t")
+		for _, st := range steps {
+			fmt.Fprintf(tw, "%d\t%d\t%c%d\t%d\t%d\t\n", st.line, st.start, st.direction, st.distance, st.end, st.zeros)
+		}
+		return tw.Flush()
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write(csvHeader)
+		for _, st := range steps {
+			cw.Write([]string{
+				strconv.Itoa(st.line), strconv.Itoa(st.start), string(st.direction),
+				strconv.Itoa(st.distance), strconv.Itoa(st.end), strconv.Itoa(st.zeros),
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown trace format %q (want text or csv)", format)
+}
+
+func (s *solver) Replay(r io.Reader) (part1, part2 aoc.Answer, err error) {
+	steps, err := readTrace(r)
+	if err != nil {
+		return part1, part2, err
+	}
+
+	endOnZero, zeros := 0, 0
+	for _, st := range steps {
+		if st.end == 0 {
+			endOnZero++
+		}
+		zeros += st.zeros
+	}
+	part1 = aoc.Int(endOnZero).Notef("%d steps", len(steps))
+	part2 = aoc.Int(zeros)
+
+	// Check every step against the dial, each from the start the trace
+	// gives it, so one bad step is reported once rather than throwing off
+	// all that follow.
+	position, bad := startPosition, 0
+	for _, st := range steps {
+		want := turn(st.line, st.start, rotation{st.direction, st.distance})
+		if st.start != position || st.end != want.end || st.zeros != want.zeros {
+			bad++
+		}
+		if st.start != position {
+			part1 = part1.Notef("line %d: starts at %d, but the step before ended at %d", st.line, st.start, position)
+		}
+		if st.end != want.end {
+			part1 = part1.Notef("line %d: %c%d from %d: trace ends at %d, the dial at %d", st.line, st.direction, st.distance, st.start, st.end, want.end)
+		}
+		if st.zeros != want.zeros {
+			part2 = part2.Notef("line %d: %c%d from %d: trace crosses zero %d times, the dial %d", st.line, st.direction, st.distance, st.start, st.zeros, want.zeros)
+		}
+		position = st.end
+	}
+	if bad > 0 {
+		return part1, part2, fmt.Errorf("%d of %d steps do not follow the dial: %w", bad, len(steps), aoc.ErrTraceMismatch)
+	}
+	return part1, part2, nil
+}
+
+// readTrace reads a trace in either format Trace writes, telling them
+// apart by the header.
+func readTrace(r io.Reader) ([]step, error) {
+	sc := parse.NewScanner(r)
+	if !sc.Scan() {
+		return nil, sc.Missing("a trace header")
+	}
+	var split func(string) []string
+	switch header := sc.Text(); {
+	case header == strings.Join(csvHeader, ","):
+		split = func(line string) []string { return strings.Split(line, ",") }
+	case strings.Join(strings.Fields(header), " ") == strings.Join(textHeader, " "):
+		split = splitTextStep
+	default:
+		return nil, sc.BadLine("a text or CSV trace header")
+	}
+
+	var steps []step
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		st, ok := parseStep(split(sc.Text()))
+		if !ok {
+			if err := sc.BadLine("line, start, direction, distance, end and zero crossings"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		steps = append(steps, st)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(steps) == 0 {
+		return nil, sc.Missing("a trace step")
+	}
+	return steps, nil
+}
+
+// splitTextStep splits a text trace row, whose turn such as "L68" holds
+// both the direction and the distance.
+func splitTextStep(line string) []string {
+	f := strings.Fields(line)
+	if len(f) != len(textHeader) {
+		return nil
+	}
+	return []string{f[0], f[1], f[2][:1], f[2][1:], f[3], f[4]}
+}
+
+func parseStep(f []string) (step, bool) {
+	if len(f) != len(csvHeader) || (f[2] != "L" && f[2] != "R") {
+		return step{}, false
+	}
+	var n [5]int
+	for i, field := range []string{f[0], f[1], f[3], f[4], f[5]} {
+		v, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil || v < 0 {
+			return step{}, false
+		}
+		n[i] = v
+	}
+	if n[1] >= dialSize || n[3] >= dialSize {
+		return step{}, false
+	}
+	return step{n[0], n[1], dial.Direction(f[2][0]), n[2], n[3], n[4]}, true
+}
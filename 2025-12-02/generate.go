@@ -0,0 +1,48 @@
+package day02
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 2, aoc.Generator{Generate: generate, Size: 35, Unit: "ID ranges"})
+}
+
+// generate writes size disjoint ID ranges of 1 to 10 digits on one line.
+// Each range spans at most 100,000 IDs, so brute force stays feasible.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	var ranges []idRange
+	for len(ranges) < size {
+		digits := 1 + rng.IntN(10)
+		lo := pow10(digits - 1)
+		if digits == 1 {
+			lo = 1
+		}
+		start := lo + rng.IntN(9*pow10(digits-1))
+		r := idRange{start, start + rng.IntN(min(100_000, start))}
+		if !overlaps(ranges, r) {
+			ranges = append(ranges, r)
+		}
+	}
+
+	parts := make([]string, len(ranges))
+	for i, r := range ranges {
+		parts[i] = fmt.Sprintf("%d-%d", r.start, r.end)
+	}
+	_, err := fmt.Fprintln(w, strings.Join(parts, ","))
+	return err
+}
+
+func overlaps(ranges []idRange, r idRange) bool {
+	for _, o := range ranges {
+		if r.start <= o.end && o.start <= r.end {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,203 @@
+/*
+find invalid product ids in ranges
+invalid ID: sequence of digits repeated twice e.g., 11, 6464, 123123
+sum all invalid ids across all ranges
+*/
+package day02
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+type idRange struct {
+	start, end int
+}
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	ranges []idRange
+}
+
+func init() {
+	aoc.Register(2025, 2, func() aoc.Solver { return &solver{} }, samples)
+}
+
+// Parse reads comma-separated START-END ranges. The list is normally one
+// line; a line may end with a comma and continue on the next.
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		for _, f := range parse.Split(sc.Text(), ",") {
+			if f.Text == "" {
+				continue
+			}
+			rng, err := parseRange(sc, f)
+			if err != nil {
+				return err
+			}
+			if rng != nil {
+				s.ranges = append(s.ranges, *rng)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if len(s.ranges) == 0 {
+		return sc.Missing("an ID range")
+	}
+	return nil
+}
+
+// maxIDDigits is the longest ID the sums handle: they take 10 to the power
+// of an ID's digit count, and 10^19 does not fit an int64.
+const maxIDDigits = 18
+
+// parseRange reads one START-END field. It returns nil, nil for a bad
+// field skipped by a lenient parse.
+func parseRange(sc *parse.Scanner, f parse.Field) (*idRange, error) {
+	bounds := parse.Split(f.Text, "-")
+	if len(bounds) != 2 {
+		return nil, sc.Bad(f.Col, "range START-END", f.Text)
+	}
+
+	var ids [2]int
+	for i, b := range bounds {
+		n, err := strconv.Atoi(b.Text)
+		if err != nil || n < 0 {
+			return nil, sc.Bad(f.Col+b.Col-1, "product ID", b.Text)
+		}
+		if digits(n) > maxIDDigits {
+			return nil, sc.Bad(f.Col+b.Col-1, fmt.Sprintf("product ID of at most %d digits", maxIDDigits), b.Text)
+		}
+		ids[i] = n
+	}
+	if ids[0] > ids[1] {
+		return nil, sc.Bad(f.Col+bounds[1].Col-1, fmt.Sprintf("range end of at least %d", ids[0]), bounds[1].Text)
+	}
+	return &idRange{ids[0], ids[1]}, nil
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Answer{Value: sumInvalid(s.ranges, repeatedTwice).String()}, nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Answer{Value: sumInvalid(s.ranges, repeatedAtLeastTwice).String()}, nil
+}
+
+// An invalid ID of n digits is a pattern of p digits repeated n/p times,
+// which is the pattern times the repeater 1 + 10^p + 10^2p + ... (e.g.
+// 123123 = 123 * 1001). The IDs of one digit count and pattern length are
+// then an arithmetic series, summed directly however wide the range.
+
+// patternWeights returns, for IDs of n digits, the pattern lengths to sum
+// over and the sign each sum is added with.
+type patternWeights func(n int) map[int]int
+
+// repeatedTwice is Part 1: the pattern repeated exactly twice.
+func repeatedTwice(n int) map[int]int {
+	if n%2 != 0 {
+		return nil
+	}
+	return map[int]int{n / 2: 1}
+}
+
+// repeatedAtLeastTwice is Part 2: any pattern length p dividing n with
+// p < n. An ID repeating a 2-digit pattern also repeats the 4- and 6-digit
+// ones built from it, so the lengths are combined by inclusion-exclusion:
+// IDs whose pattern divides both p and q are those whose pattern divides
+// gcd(p, q), and with the Möbius function the union comes out as
+// -Σ μ(n/p)·S(p). For 12 digits that is S(6) + S(4) - S(2).
+func repeatedAtLeastTwice(n int) map[int]int {
+	weights := make(map[int]int)
+	for p := 1; p < n; p++ {
+		if n%p == 0 {
+			if mu := mobius(n / p); mu != 0 {
+				weights[p] = -mu
+			}
+		}
+	}
+	return weights
+}
+
+// sumInvalid adds up every invalid ID in the ranges, with weights saying
+// which pattern lengths make an ID of each digit count invalid. The IDs fit
+// an int but their sum need not: every 14-digit ID repeating a 7-digit
+// pattern already adds up to about 5e20.
+func sumInvalid(ranges []idRange, weights patternWeights) *big.Int {
+	sum := new(big.Int)
+	for _, r := range ranges {
+		for n := digits(r.start); n <= digits(r.end); n++ {
+			lo, hi := max(r.start, pow10(n-1)), min(r.end, pow10(n)-1)
+			for p, sign := range weights(n) {
+				term := sumRepeats(lo, hi, n, p)
+				sum.Add(sum, term.Mul(term, big.NewInt(int64(sign))))
+			}
+		}
+	}
+	return sum
+}
+
+// sumRepeats adds up the n-digit numbers in [lo, hi] that repeat a p-digit
+// pattern, p dividing n.
+func sumRepeats(lo, hi, n, p int) *big.Int {
+	repeater := (pow10(n) - 1) / (pow10(p) - 1)
+	first := max(pow10(p-1), (lo+repeater-1)/repeater)
+	last := min(pow10(p)-1, hi/repeater)
+	if first > last {
+		return new(big.Int)
+	}
+	// repeater * (first + last) * count / 2; the product is even.
+	sum := big.NewInt(int64(first + last))
+	sum.Mul(sum, big.NewInt(int64(last-first+1)))
+	sum.Mul(sum, big.NewInt(int64(repeater)))
+	return sum.Rsh(sum, 1)
+}
+
+// mobius returns μ(n): 0 if n has a squared prime factor, otherwise -1 or
+// 1 for an odd or even number of prime factors.
+func mobius(n int) int {
+	mu := 1
+	for f := 2; f*f <= n; f++ {
+		if n%f == 0 {
+			n /= f
+			if n%f == 0 {
+				return 0
+			}
+			mu = -mu
+		}
+	}
+	if n > 1 {
+		mu = -mu
+	}
+	return mu
+}
+
+func pow10(n int) int {
+	p := 1
+	for range n {
+		p *= 10
+	}
+	return p
+}
+
+// digits returns the number of decimal digits in n, counting 0 as one.
+func digits(n int) int {
+	d := 1
+	for n >= 10 {
+		n /= 10
+		d++
+	}
+	return d
+}
@@ -0,0 +1,194 @@
+package day02
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/difftest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "1227775554", Part2: "4174379265"},
+	})
+}
+
+// isInvalidPart1 and isInvalidPart2 test one ID at a time, as the
+// solution once did; sumInvalid must agree with them.
+func isInvalidPart1(n int) bool {
+	s := strconv.Itoa(n)
+
+	if len(s)%2 != 0 {
+		return false
+	}
+
+	mid := len(s) / 2
+	left := s[:mid]
+	right := s[mid:]
+
+	return left == right
+}
+
+func isInvalidPart2(n int) bool {
+	s := strconv.Itoa(n)
+	length := len(s)
+
+	for patternLen := 1; patternLen <= length/2; patternLen++ {
+		if length%patternLen == 0 {
+			pattern := s[:patternLen]
+			if strings.Repeat(pattern, length/patternLen) == s {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// bruteSum scans every ID in the ranges.
+func bruteSum(ranges []idRange, isInvalid func(int) bool) int {
+	sum := 0
+	for _, r := range ranges {
+		for i := r.start; i <= r.end; i++ {
+			if isInvalid(i) {
+				sum += i
+			}
+		}
+	}
+	return sum
+}
+
+func TestIsInvalid(t *testing.T) {
+	tests := []struct {
+		n            int
+		part1, part2 bool
+	}{
+		{11, true, true},
+		{12, false, false},
+		{6464, true, true},
+		{123123, true, true},
+		{111, false, true},
+		{121212, false, true},
+		{1111, true, true},
+		{1010, true, true},
+		{1001, false, false},
+		{7, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := isInvalidPart1(tt.n); got != tt.part1 {
+			t.Errorf("isInvalidPart1(%d) = %v, want %v", tt.n, got, tt.part1)
+		}
+		if got := isInvalidPart2(tt.n); got != tt.part2 {
+			t.Errorf("isInvalidPart2(%d) = %v, want %v", tt.n, got, tt.part2)
+		}
+	}
+}
+
+func TestMobius(t *testing.T) {
+	want := []int{1, -1, -1, 0, -1, 1, -1, 0, 0, 1, -1, 0}
+	for n := 1; n <= len(want); n++ {
+		if got := mobius(n); got != want[n-1] {
+			t.Errorf("mobius(%d) = %d, want %d", n, got, want[n-1])
+		}
+	}
+}
+
+// The series sums must agree with scanning every ID, on ranges crossing
+// digit counts, where patterns like 1111 fit several lengths at once.
+func TestAgainstOracle(t *testing.T) {
+	for _, part := range []struct {
+		name      string
+		weights   patternWeights
+		isInvalid func(int) bool
+	}{
+		{"part 1", repeatedTwice, isInvalidPart1},
+		{"part 2", repeatedAtLeastTwice, isInvalidPart2},
+	} {
+		t.Run(part.name, func(t *testing.T) {
+			difftest.Run(t, difftest.Test[[]idRange, int]{
+				Generate: func(rng *rand.Rand) []idRange {
+					var ranges []idRange
+					for range 1 + rng.IntN(3) {
+						start := pow10(rng.IntN(7)) - 1 + rng.IntN(5_000)
+						ranges = append(ranges, idRange{start, start + rng.IntN(5_000)})
+					}
+					return ranges
+				},
+				Shrink: func(ranges []idRange) [][]idRange {
+					return difftest.ShrinkSlice(ranges, func(r idRange) []idRange {
+						var smaller []idRange
+						for _, end := range difftest.ShrinkInt(r.end - r.start) {
+							smaller = append(smaller, idRange{r.start, r.start + end})
+						}
+						return smaller
+					})
+				},
+				Oracle: func(ranges []idRange) int { return bruteSum(ranges, part.isInvalid) },
+				Fast:   func(ranges []idRange) int { return int(sumInvalid(ranges, part.weights).Int64()) },
+				Format: func(ranges []idRange) string {
+					fields := make([]string, len(ranges))
+					for i, r := range ranges {
+						fields[i] = fmt.Sprintf("%d-%d", r.start, r.end)
+					}
+					return strings.Join(fields, ",") + "\n"
+				},
+			})
+		})
+	}
+}
+
+// Every ID of up to 12 digits: about a trillion to scan, six series per
+// part to sum. The answers were worked out by listing the repeated IDs.
+// TestWideRange sums every ID up to 12, 14 and 18 digits, the last two far
+// past what an int holds. The wants come from summing each digit count's
+// IDs by their shortest repeating pattern instead.
+func TestWideRange(t *testing.T) {
+	tests := []struct {
+		digits       int
+		part1, part2 string
+	}{
+		{12, "495495540949540950", "500397481094131395"},
+		{14, "495495500040945040950", "495504906526544176800"},
+		{18, "495495495540950040450040950", "495990051040401571498681800"},
+	}
+	for _, tt := range tests {
+		ranges := []idRange{{1, pow10(tt.digits) - 1}}
+		if got := sumInvalid(ranges, repeatedTwice).String(); got != tt.part1 {
+			t.Errorf("Part 1 over %d digits = %s, want %s", tt.digits, got, tt.part1)
+		}
+		if got := sumInvalid(ranges, repeatedAtLeastTwice).String(); got != tt.part2 {
+			t.Errorf("Part 2 over %d digits = %s, want %s", tt.digits, got, tt.part2)
+		}
+	}
+}
+
+// TestLongestIDs sums at the 18 digits IDs are limited to, where only the
+// all-nines ID 999999999 999999999 is invalid for either part.
+func TestLongestIDs(t *testing.T) {
+	ranges := []idRange{{999999998999999999, 999999999999999999}}
+	for part, weights := range map[int]patternWeights{1: repeatedTwice, 2: repeatedAtLeastTwice} {
+		if got, want := sumInvalid(ranges, weights).String(), "999999999999999999"; got != want {
+			t.Errorf("Part %d over 18 digits = %s, want %s", part, got, want)
+		}
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "not a range", Input: "11-22,95\n", Err: `line 1:7: expected range START-END, got "95"`},
+		{Name: "bad end", Input: "11-22,95-1x5\n", Err: `line 1:10: expected product ID, got "1x5"`},
+		{Name: "too long", Input: "11-1000000000000000000\n", Err: `line 1:4: expected product ID of at most 18 digits, got "1000000000000000000"`},
+		{Name: "reversed", Input: "11-22, 95-15\n", Err: `line 1:11: expected range end of at least 95, got "15"`},
+		{Name: "empty", Input: "", Err: `line 1: expected an ID range, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 20)
+}
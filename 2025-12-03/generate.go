@@ -0,0 +1,31 @@
+package day03
+
+import (
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+// bankSize is the number of batteries in every generated bank, as in the
+// real input.
+const bankSize = 100
+
+func init() {
+	aoc.RegisterGenerator(2025, 3, aoc.Generator{Generate: generate, Size: 200, Unit: "banks"})
+}
+
+// generate writes size banks of bankSize random joltage digits.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	bank := make([]byte, bankSize+1)
+	bank[bankSize] = '\n'
+	for range size {
+		for i := range bankSize {
+			bank[i] = byte('1' + rng.IntN(9))
+		}
+		if _, err := w.Write(bank); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+row: bank of batteries
+number: one batteries joltage (1-9)
+part1: find each row max 2-digit joltage, sum it
+part2: find each row max 12-digit joltage, sum it
+*/
+package day03
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+)
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	banks   []string
+	lines   []int // input line of each bank
+	workers int
+}
+
+func init() {
+	aoc.Register(2025, 3, func() aoc.Solver { return &solver{workers: workpool.Default()} }, samples)
+}
+
+func (s *solver) SetWorkers(n int) { s.workers = n }
+
+// minBank is the fewest batteries a bank can have, the two part 1 turns on.
+// Part 2 turns on partTwoBatteries and fails on a bank shorter than that.
+const (
+	minBank          = 2
+	partTwoBatteries = 12
+)
+
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		bank := sc.Text()
+		if len(bank) == 0 {
+			continue
+		}
+		if i := strings.IndexFunc(bank, func(c rune) bool { return c < '1' || c > '9' }); i != -1 {
+			if err := sc.Bad(i+1, "joltage digit 1-9", bank[i:i+1]); err != nil {
+				return err
+			}
+			continue
+		}
+		if len(bank) < minBank {
+			if err := sc.Bad(len(bank)+1, fmt.Sprintf("a bank of at least %d batteries", minBank), ""); err != nil {
+				return err
+			}
+			continue
+		}
+		s.banks = append(s.banks, bank)
+		s.lines = append(s.lines, sc.Line())
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if len(s.banks) == 0 {
+		return sc.Missing("a battery bank")
+	}
+	return nil
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(s.totalJoltage(2)), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	for i, bank := range s.banks {
+		if len(bank) < partTwoBatteries {
+			return aoc.Answer{}, fmt.Errorf("line %d: a bank of %d batteries cannot turn on %d", s.lines[i], len(bank), partTwoBatteries)
+		}
+	}
+	return aoc.Int(s.totalJoltage(partTwoBatteries)), nil
+}
+
+// totalJoltage sums every bank's maxJoltage with n batteries on.
+func (s *solver) totalJoltage(n int) int64 {
+	joltages := workpool.Map(s.workers, s.banks, func(_ int, bank string) int64 {
+		return maxJoltage(bank, n)
+	})
+	total := int64(0)
+	for _, j := range joltages {
+		total += j
+	}
+	return total
+}
+
+// maxJoltage returns the largest number formed by turning on n of the
+// bank's batteries, keeping their order. It walks the bank once, dropping
+// an earlier digit whenever a larger one arrives and enough batteries
+// remain to still turn on n.
+func maxJoltage(bank string, n int) int64 {
+	toRemove := len(bank) - n
+	result := make([]byte, 0, len(bank))
+
+	for i := 0; i < len(bank); i++ {
+		digit := bank[i]
+		for len(result) > 0 && toRemove > 0 && result[len(result)-1] < digit {
+			result = result[:len(result)-1]
+			toRemove--
+		}
+		result = append(result, digit)
+	}
+
+	joltage, _ := strconv.ParseInt(string(result[:n]), 10, 64)
+	return joltage
+}
@@ -0,0 +1,149 @@
+package day03
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/difftest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "357", Part2: "3121910778619"},
+	})
+}
+
+func TestMaxJoltage(t *testing.T) {
+	tests := []struct {
+		bank   string
+		two    int
+		twelve int64
+	}{
+		{"987654321111111", 98, 987654321111},
+		{"811111111111119", 89, 811111111119},
+		{"234234234234278", 78, 434234234278},
+		{"818181911112111", 92, 888911112111},
+	}
+
+	for _, tt := range tests {
+		if got := maxJoltage(tt.bank, 2); got != int64(tt.two) {
+			t.Errorf("maxJoltage(%s, 2) = %d, want %d", tt.bank, got, tt.two)
+		}
+		if got := maxJoltage(tt.bank, 12); got != tt.twelve {
+			t.Errorf("maxJoltage(%s, 12) = %d, want %d", tt.bank, got, tt.twelve)
+		}
+		if got := findMaxJoltage(tt.bank); got != tt.two {
+			t.Errorf("findMaxJoltage(%s) = %d, want %d", tt.bank, got, tt.two)
+		}
+	}
+}
+
+// joltageInput is a bank and how many of its batteries to turn on.
+type joltageInput struct {
+	bank string
+	n    int
+}
+
+// The greedy maxJoltage must agree with trying every pair of batteries,
+// and with trying every choice of n batteries in banks short enough to
+// enumerate.
+func TestAgainstOracle(t *testing.T) {
+	difftest.Run(t, difftest.Test[joltageInput, int64]{
+		Generate: func(rng *rand.Rand) joltageInput {
+			bank := make([]byte, 2+rng.IntN(16))
+			for i := range bank {
+				bank[i] = byte('1' + rng.IntN(9))
+			}
+			return joltageInput{string(bank), 1 + rng.IntN(len(bank))}
+		},
+		Shrink: func(in joltageInput) []joltageInput {
+			var variants []joltageInput
+			for _, bank := range difftest.ShrinkString(in.bank) {
+				variants = append(variants, joltageInput{bank, in.n})
+			}
+			for _, n := range difftest.ShrinkInt(in.n) {
+				variants = append(variants, joltageInput{in.bank, n})
+			}
+			return variants
+		},
+		Valid: func(in joltageInput) bool { return 0 < in.n && in.n <= len(in.bank) },
+		Oracle: func(in joltageInput) int64 {
+			if in.n == 2 {
+				return int64(findMaxJoltage(in.bank))
+			}
+			return bruteJoltage(in.bank, in.n)
+		},
+		Fast:   func(in joltageInput) int64 { return maxJoltage(in.bank, in.n) },
+		Format: func(in joltageInput) string { return fmt.Sprintf("%s (turn on %d)", in.bank, in.n) },
+	})
+}
+
+// findMaxJoltage tries every pair of batteries.
+func findMaxJoltage(bank string) int {
+	max := 0
+
+	for i := 0; i < len(bank); i++ {
+		first, _ := strconv.Atoi(string(bank[i]))
+		for j := i + 1; j < len(bank); j++ {
+			second, _ := strconv.Atoi(string(bank[j]))
+			joltage := first*10 + second
+			if joltage > max {
+				max = joltage
+			}
+		}
+	}
+
+	return max
+}
+
+// bruteJoltage tries every choice of n batteries.
+func bruteJoltage(bank string, n int) int64 {
+	if n == 0 {
+		return 0
+	}
+	best := int64(-1)
+	for i := 0; i+n <= len(bank); i++ {
+		rest := bruteJoltage(bank[i+1:], n-1)
+		if rest < 0 {
+			continue
+		}
+		joltage := int64(bank[i] - '0')
+		for range n - 1 {
+			joltage *= 10
+		}
+		best = max(best, joltage+rest)
+	}
+	return best
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad digit", Input: "987654321111111\n81181x191111119\n", Err: `line 2:6: expected joltage digit 1-9, got "x"`},
+		{Name: "zero", Input: "987654321011111\n", Err: `line 1:10: expected joltage digit 1-9, got "0"`},
+		{Name: "short bank", Input: "9\n", Err: `line 1:2: expected a bank of at least 2 batteries, got end of line`},
+		{Name: "empty", Input: "\n\n", Err: `line 3: expected a battery bank, got end of input`},
+	})
+}
+
+func TestShortBanks(t *testing.T) {
+	s := &solver{workers: 1}
+	if err := s.Parse(strings.NewReader("98765\n811111111111119\n")); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got, err := s.Part1(t.Context()); err != nil || got.Value != "187" {
+		t.Errorf("Part1 = %q, %v, want 187", got.Value, err)
+	}
+	want := "line 1: a bank of 5 batteries cannot turn on 12"
+	if _, err := s.Part2(t.Context()); err == nil || err.Error() != want {
+		t.Errorf("Part2 error = %v, want %q", err, want)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 50)
+}
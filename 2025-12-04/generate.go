@@ -0,0 +1,31 @@
+package day04
+
+import (
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 4, aoc.Generator{Generate: generate, Size: 135, Unit: "grid rows"})
+}
+
+// generate writes a square grid size cells wide with about two thirds of
+// the cells holding a roll of paper.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	row := make([]byte, size+1)
+	row[size] = '\n'
+	for range size {
+		for i := range size {
+			row[i] = '.'
+			if rng.IntN(3) != 0 {
+				row[i] = '@'
+			}
+		}
+		if _, err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
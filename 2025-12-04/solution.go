@@ -0,0 +1,75 @@
+/*
+grid: paper rolls (@) and empty spaces (.)
+part1: count accessible rolls (< 4 neighbors)
+part2: iteratively remove accessible rolls until none remain
+*/
+package day04
+
+import (
+	"context"
+	"embed"
+	"io"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+	"github.com/xinyun2020/advent-of-code/aoc/grid"
+)
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	grid grid.Grid
+}
+
+func init() {
+	aoc.Register(2025, 4, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := grid.ReadValid(r, ".@")
+	s.grid = g
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(countAccessible(s.grid)), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(countRemovable(s.grid)), nil
+}
+
+func countAccessible(g grid.Grid) int {
+	return len(filterAccessible(g))
+}
+
+func countRemovable(g grid.Grid) int {
+	mutableGrid := g.Clone()
+
+	totalRemoved := 0
+	for {
+		accessible := filterAccessible(mutableGrid)
+		if len(accessible) == 0 {
+			break
+		}
+
+		for _, pos := range accessible {
+			mutableGrid.Set(pos, '.')
+		}
+		totalRemoved += len(accessible)
+	}
+
+	return totalRemoved
+}
+
+// filterAccessible returns the rolls with fewer than four rolls around them.
+func filterAccessible(g grid.Grid) []geom.Point {
+	var accessible []geom.Point
+	for _, pos := range g.All('@') {
+		if g.Count(pos, grid.Dirs8, '@') < 4 {
+			accessible = append(accessible, pos)
+		}
+	}
+	return accessible
+}
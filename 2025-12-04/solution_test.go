@@ -0,0 +1,50 @@
+package day04
+
+import (
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/grid"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "13", Part2: "43"},
+	})
+}
+
+func TestCountRolls(t *testing.T) {
+	tests := []struct {
+		name                  string
+		grid                  []string
+		accessible, removable int
+	}{
+		{"single roll", []string{"@"}, 1, 1},
+		{"empty", []string{"..", ".."}, 0, 0},
+		{"full 3x3", []string{"@@@", "@@@", "@@@"}, 4, 9},
+		{"plus sign", []string{".@.", "@@@", ".@."}, 4, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := countAccessible(grid.FromLines(tt.grid)); got != tt.accessible {
+				t.Errorf("countAccessible = %d, want %d", got, tt.accessible)
+			}
+			if got := countRemovable(grid.FromLines(tt.grid)); got != tt.removable {
+				t.Errorf("countRemovable = %d, want %d", got, tt.removable)
+			}
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad cell", Input: "..@\n.#.\n", Err: `line 2:2: expected one of ".@", got "#"`},
+		{Name: "short row", Input: "..@\n.@\n", Err: `line 2:3: expected a row 3 wide, got end of line`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 30)
+}
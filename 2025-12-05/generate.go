@@ -0,0 +1,46 @@
+package day05
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 5, aoc.Generator{Generate: generate, Size: 180, Unit: "fresh ID ranges"})
+}
+
+// generate writes size overlapping 15-digit fresh ID ranges, then 1000
+// ingredient IDs of which about half fall inside a range.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const (
+		low  = 100_000_000_000_000
+		high = 560_000_000_000_000
+		span = 5_000_000_000_000
+	)
+	ranges := make([]Range, size)
+	for i := range ranges {
+		start := low + rng.Int64N(high-low)
+		ranges[i] = Range{Lo: start, Hi: start + rng.Int64N(span)}
+		if _, err := fmt.Fprintf(w, "%d-%d\n", ranges[i].Lo, ranges[i].Hi); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	for range 1000 {
+		id := low + rng.Int64N(high-low+span)
+		if len(ranges) > 0 && rng.IntN(2) == 0 {
+			r := ranges[rng.IntN(len(ranges))]
+			id = r.Lo + rng.Int64N(r.Hi-r.Lo+1)
+		}
+		if _, err := fmt.Fprintln(w, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+part 1: check which ingredients fall within ranges
+part 2: merge overlapping ranges and count total fresh IDs
+*/
+package day05
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/interval"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// Range is an inclusive span of fresh ingredient IDs.
+type Range = interval.Interval[int64]
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	ranges      []Range
+	ingredients []int64
+}
+
+func init() {
+	aoc.Register(2025, 5, func() aoc.Solver { return &solver{} }, samples)
+}
+
+// Parse reads the fresh ID ranges, a blank line, then the available
+// ingredient IDs.
+func (s *solver) Parse(r io.Reader) error {
+	parsingRanges := true
+
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		// indent turns columns in line into columns in the raw line.
+		indent := strings.Index(sc.Text(), line)
+
+		if line == "" {
+			parsingRanges = false
+			continue
+		}
+
+		if parsingRanges {
+			bounds := parse.Split(line, "-")
+			if len(bounds) != 2 {
+				if err := sc.BadLine("range START-END"); err != nil {
+					return err
+				}
+				continue
+			}
+			start, err := strconv.ParseInt(bounds[0].Text, 10, 64)
+			if err != nil {
+				if err := sc.Bad(indent+bounds[0].Col, "range start", bounds[0].Text); err != nil {
+					return err
+				}
+				continue
+			}
+			end, err := strconv.ParseInt(bounds[1].Text, 10, 64)
+			if err != nil || end < start {
+				if err := sc.Bad(indent+bounds[1].Col, fmt.Sprintf("range end of at least %d", start), bounds[1].Text); err != nil {
+					return err
+				}
+				continue
+			}
+			s.ranges = append(s.ranges, Range{Lo: start, Hi: end})
+		} else {
+			id, err := strconv.ParseInt(line, 10, 64)
+			if err != nil {
+				if err := sc.BadLine("ingredient ID"); err != nil {
+					return err
+				}
+				continue
+			}
+			s.ingredients = append(s.ingredients, id)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	switch {
+	case len(s.ranges) == 0:
+		return sc.Missing("a fresh ID range")
+	case parsingRanges:
+		return sc.Missing("a blank line and the ingredient IDs")
+	case len(s.ingredients) == 0:
+		return sc.Missing("an ingredient ID")
+	}
+	return nil
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(countFresh(s.ingredients, s.ranges)), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(countTotalFreshIDs(s.ranges)), nil
+}
+
+// countFresh returns how many ids fall in at least one range. It merges
+// the ranges once so each lookup is a binary search.
+func countFresh(ids []int64, ranges []Range) int {
+	merged := interval.Merge(ranges)
+	count := 0
+	for _, id := range ids {
+		if interval.Find(merged, id) {
+			count++
+		}
+	}
+	return count
+}
+
+func countTotalFreshIDs(ranges []Range) int64 {
+	return interval.Total(ranges)
+}
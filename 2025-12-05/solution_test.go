@@ -0,0 +1,107 @@
+package day05
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/difftest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "3", Part2: "14"},
+	})
+}
+
+func TestIsFresh(t *testing.T) {
+	ranges := []Range{{Lo: 3, Hi: 5}, {Lo: 10, Hi: 14}, {Lo: 16, Hi: 20}, {Lo: 12, Hi: 18}}
+	for id, want := range map[int64]bool{1: false, 5: true, 8: false, 11: true, 17: true, 32: false} {
+		if got := isFresh(id, ranges); got != want {
+			t.Errorf("isFresh(%d) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+// inventory is the puzzle input: fresh ID ranges and the available IDs.
+type inventory struct {
+	ranges []Range
+	ids    []int64
+}
+
+// countFresh must agree with checking every ID against every range, on
+// small IDs where ranges overlap, touch and nest often.
+func TestAgainstOracle(t *testing.T) {
+	difftest.Run(t, difftest.Test[inventory, int]{
+		Generate: func(rng *rand.Rand) inventory {
+			var in inventory
+			for range 1 + rng.IntN(8) {
+				lo := rng.Int64N(40)
+				in.ranges = append(in.ranges, Range{Lo: lo, Hi: lo + rng.Int64N(10)})
+			}
+			for range 1 + rng.IntN(20) {
+				in.ids = append(in.ids, rng.Int64N(55))
+			}
+			return in
+		},
+		Shrink: func(in inventory) []inventory {
+			var variants []inventory
+			for _, ranges := range difftest.ShrinkSlice(in.ranges, nil) {
+				variants = append(variants, inventory{ranges, in.ids})
+			}
+			for _, ids := range difftest.ShrinkSlice(in.ids, difftest.ShrinkInt) {
+				variants = append(variants, inventory{in.ranges, ids})
+			}
+			return variants
+		},
+		Oracle: func(in inventory) int {
+			count := 0
+			for _, id := range in.ids {
+				if isFresh(id, in.ranges) {
+					count++
+				}
+			}
+			return count
+		},
+		Fast: func(in inventory) int { return countFresh(in.ids, in.ranges) },
+		Format: func(in inventory) string {
+			var b strings.Builder
+			for _, r := range in.ranges {
+				fmt.Fprintf(&b, "%d-%d\n", r.Lo, r.Hi)
+			}
+			b.WriteString("\n")
+			for _, id := range in.ids {
+				fmt.Fprintf(&b, "%d\n", id)
+			}
+			return b.String()
+		},
+	})
+}
+
+// isFresh checks id against every range in turn.
+func isFresh(id int64, ranges []Range) bool {
+	for _, r := range ranges {
+		if r.Contains(id) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad range", Input: "3-5\n10-1x\n\n1\n", Err: `line 2:4: expected range end of at least 10, got "1x"`},
+		{Name: "indented", Input: "3-5\n  10-1x\n\n1\n", Err: `line 2:6: expected range end of at least 10, got "1x"`},
+		{Name: "not a range", Input: "3-5\n10\n\n1\n", Err: `line 2:1: expected range START-END, got "10"`},
+		{Name: "bad ID", Input: "3-5\n\n1\nfive\n", Err: `line 4:1: expected ingredient ID, got "five"`},
+		{Name: "truncated", Input: "3-5\n10-14\n", Err: `line 3: expected a blank line and the ingredient IDs, got end of input`},
+		{Name: "no IDs", Input: "3-5\n\n", Err: `line 3: expected an ingredient ID, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 50)
+}
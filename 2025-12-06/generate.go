@@ -0,0 +1,71 @@
+package day06
+
+import (
+	"bytes"
+	"io"
+	"math/rand/v2"
+	"strconv"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+// numberRows is how many numbers each generated problem has, as in the
+// real input.
+const numberRows = 4
+
+func init() {
+	aoc.RegisterGenerator(2025, 6, aoc.Generator{Generate: generate, Size: 1000, Unit: "problems"})
+}
+
+// generate writes a worksheet of size problems side by side. Each problem
+// is numberRows numbers, all aligned left or all right, over its operator,
+// and is separated from the next by a column of spaces. Sums take numbers
+// of up to 4 digits and products up to 3, which keeps the grand total well
+// inside an int64 however the columns are read.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	rows := make([]bytes.Buffer, numberRows+1)
+	for p := range size {
+		if p > 0 {
+			for i := range rows {
+				rows[i].WriteByte(' ')
+			}
+		}
+
+		op := byte('+')
+		maxDigits := 4
+		if rng.IntN(2) == 0 {
+			op, maxDigits = '*', 3
+		}
+
+		nums := make([]string, numberRows)
+		width := 0
+		for i := range nums {
+			digits := 1 + rng.IntN(maxDigits)
+			lo := []int{1, 10, 100, 1000}[digits-1]
+			nums[i] = strconv.Itoa(lo + rng.IntN(9*lo))
+			width = max(width, len(nums[i]))
+		}
+		leftAligned := rng.IntN(2) == 0
+		for i, n := range nums {
+			pad := bytes.Repeat([]byte{' '}, width-len(n))
+			if leftAligned {
+				rows[i].WriteString(n)
+				rows[i].Write(pad)
+			} else {
+				rows[i].Write(pad)
+				rows[i].WriteString(n)
+			}
+		}
+
+		rows[numberRows].WriteByte(op)
+		rows[numberRows].Write(bytes.Repeat([]byte{' '}, width-1))
+	}
+
+	for _, row := range rows {
+		row.WriteByte('\n')
+		if _, err := row.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
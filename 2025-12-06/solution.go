@@ -1,40 +1,84 @@
 /*
-	parse vertical math worksheet
-	problems separated by full column of spaces
-	part 2: read columns right-to-left, digits top-to-bottom
+parse vertical math worksheet
+problems separated by full column of spaces
+last row holds the operators, every row above it digits
+part 2: read columns right-to-left, digits top-to-bottom
 */
-package main
+package day06
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"context"
+	"embed"
+	"io"
 	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
 )
 
-func main() {
-	file, err := os.Open("input.txt")
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	rows []string
+}
+
+func init() {
+	aoc.Register(2025, 6, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	var lines []int // input line of each row
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		s.rows = append(s.rows, sc.Text())
+		lines = append(lines, sc.Line())
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	if len(s.rows) < 2 {
+		return sc.Missing("number rows above an operator row")
 	}
-	defer file.Close()
 
-	rows := []string{}
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		rows = append(rows, scanner.Text())
+	// A lenient parse drops malformed rows. Without its operator row the
+	// worksheet has no problems left to work.
+	last := len(s.rows) - 1
+	var kept []string
+	for i, row := range s.rows {
+		allowed, expected := "0123456789 ", "digit"
+		if i == last {
+			allowed, expected = "+* ", "operator + or *"
+		}
+		if j := strings.IndexFunc(row, func(c rune) bool { return !strings.ContainsRune(allowed, c) }); j != -1 {
+			if err := sc.BadAt(lines[i], j+1, expected, row[j:j+1]); err != nil {
+				return err
+			}
+			if i == last {
+				kept = nil
+			}
+			continue
+		}
+		kept = append(kept, row)
 	}
+	s.rows = kept
+	return nil
+}
 
-	part1 := parseWorksheetPart1(rows)
-	fmt.Printf("Part 1: %d\n", part1)
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(parseWorksheetPart1(s.rows)), nil
+}
 
-	part2 := parseWorksheetPart2(rows)
-	fmt.Printf("Part 2: %d\n", part2)
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(parseWorksheetPart2(s.rows)), nil
 }
 
 func parseWorksheetPart1(rows []string) int64 {
-	if len(rows) < 5 {
+	if len(rows) < 2 {
 		return 0
 	}
 
@@ -88,12 +132,12 @@ func parseWorksheetPart1(rows []string) int64 {
 }
 
 func solveProblemPart1(rows []string) int64 {
-	if len(rows) < 5 {
+	if len(rows) < 2 {
 		return 0
 	}
 
 	operator := byte(0)
-	operatorRow := rows[4]
+	operatorRow := rows[len(rows)-1]
 	for _, ch := range operatorRow {
 		if ch == '+' || ch == '*' {
 			operator = byte(ch)
@@ -106,7 +150,7 @@ func solveProblemPart1(rows []string) int64 {
 	}
 
 	numbers := []int64{}
-	for i := 0; i < 4; i++ {
+	for i := 0; i < len(rows)-1; i++ {
 		numStr := ""
 		for _, ch := range rows[i] {
 			if ch >= '0' && ch <= '9' {
@@ -136,7 +180,7 @@ func solveProblemPart1(rows []string) int64 {
 }
 
 func parseWorksheetPart2(rows []string) int64 {
-	if len(rows) < 5 {
+	if len(rows) < 2 {
 		return 0
 	}
 
@@ -190,13 +234,13 @@ func parseWorksheetPart2(rows []string) int64 {
 }
 
 func solveProblemPart2(rows []string) int64 {
-	if len(rows) < 5 {
+	if len(rows) < 2 {
 		return 0
 	}
 
 	// Find operator
 	operator := byte(0)
-	operatorRow := rows[4]
+	operatorRow := rows[len(rows)-1]
 	for _, ch := range operatorRow {
 		if ch == '+' || ch == '*' {
 			operator = byte(ch)
@@ -214,7 +258,7 @@ func solveProblemPart2(rows []string) int64 {
 	for col := width - 1; col >= 0; col-- {
 		// Read digits top-to-bottom in this column
 		numStr := ""
-		for row := 0; row < 4; row++ {
+		for row := 0; row < len(rows)-1; row++ {
 			if col < len(rows[row]) {
 				ch := rows[row][col]
 				if ch >= '0' && ch <= '9' {
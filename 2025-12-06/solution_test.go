@@ -0,0 +1,80 @@
+package day06
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "4277556", Part2: "3263827"},
+	})
+}
+
+func TestSolveProblem(t *testing.T) {
+	// The example's problems, each cut out of the worksheet on its own.
+	tests := []struct {
+		rows         []string
+		part1, part2 int64
+	}{
+		{[]string{"123", " 45", "  6", "*  "}, 33210, 8544},
+		{[]string{"328", "64 ", "98 ", "+  "}, 490, 625},
+		{[]string{" 51", "387", "215", "*  "}, 4243455, 3253600},
+		{[]string{"64 ", "23 ", "314", "+  "}, 401, 1058},
+	}
+
+	for _, tt := range tests {
+		if got := solveProblemPart1(tt.rows); got != tt.part1 {
+			t.Errorf("solveProblemPart1(%q) = %d, want %d", tt.rows, got, tt.part1)
+		}
+		if got := solveProblemPart2(tt.rows); got != tt.part2 {
+			t.Errorf("solveProblemPart2(%q) = %d, want %d", tt.rows, got, tt.part2)
+		}
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad digit", Input: "123 328\n 4x 64 \n*   +  \n", Err: `line 2:3: expected digit, got "x"`},
+		{Name: "bad operator", Input: "123 328\n 45 64 \n*   -  \n", Err: `line 3:5: expected operator + or *, got "-"`},
+		{Name: "no operators", Input: "123 328\n", Err: `line 2: expected number rows above an operator row, got end of input`},
+	})
+}
+
+func TestLenientDropsRows(t *testing.T) {
+	tests := []struct {
+		name, input  string
+		part1, part2 string
+	}{
+		// Without row 2, the problems are 123 * 6 and 328 + 98, or read
+		// by column 1 * 2 * 36 and 39 + 28 + 8.
+		{"bad digit", "123 328\n 4x 64 \n  6 98 \n*   +  \n", "1164", "147"},
+		{"bad operator", "123 328\n 45 64 \n*   -  \n", "0", "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := parse.NewSource("in", strings.NewReader(tt.input))
+			src.Lenient = true
+			s := &solver{}
+			if err := s.Parse(src); err != nil {
+				t.Fatal(err)
+			}
+			if n := len(src.Warnings()); n != 1 {
+				t.Errorf("%d warnings, want 1", n)
+			}
+			p1, _ := s.Part1(t.Context())
+			p2, _ := s.Part2(t.Context())
+			if p1.Value != tt.part1 || p2.Value != tt.part2 {
+				t.Errorf("answers = %s, %s, want %s, %s", p1.Value, p2.Value, tt.part1, tt.part2)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 100)
+}
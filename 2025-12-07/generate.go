@@ -0,0 +1,56 @@
+package day07
+
+import (
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 7, aoc.Generator{Generate: generate, Size: 70, Unit: "splitter rows"})
+}
+
+// generate writes a manifold with size rows of splitters below the start,
+// each followed by an empty row. Splitters sit only where a beam could
+// arrive, spreading out from the start like the real input, and about
+// three in four of those places hold one.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	width := 2*size + 1
+	start := size
+	row := make([]byte, width+1)
+	row[width] = '\n'
+	write := func(fill func(c int) byte) error {
+		for c := range width {
+			row[c] = fill(c)
+		}
+		_, err := w.Write(row)
+		return err
+	}
+	empty := func(int) byte { return '.' }
+
+	if err := write(func(c int) byte {
+		if c == start {
+			return 'S'
+		}
+		return '.'
+	}); err != nil {
+		return err
+	}
+	for k := range size {
+		if err := write(empty); err != nil {
+			return err
+		}
+		// Beams reach row k+1 of splitters at start-k, start-k+2, ... start+k.
+		if err := write(func(c int) byte {
+			d := c - start
+			if d >= -k && d <= k && (d+k)%2 == 0 && rng.IntN(4) != 0 {
+				return '^'
+			}
+			return '.'
+		}); err != nil {
+			return err
+		}
+	}
+	return write(empty)
+}
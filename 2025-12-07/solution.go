@@ -1,35 +1,67 @@
 /*
-	simulate tachyon beams moving downward through manifold
-	beams split when hitting '^' creating left and right beams
-	count total number of splits
+simulate tachyon beams moving downward through manifold
+beams split when hitting '^' creating left and right beams
+count total number of splits
 */
-package main
+package day07
 
 import (
-	"bufio"
+	"context"
+	"embed"
 	"fmt"
-	"os"
+	"io"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
 )
 
-func main() {
-	file, err := os.Open("input.txt")
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
-	}
-	defer file.Close()
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	grid []string
+}
 
-	grid := []string{}
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		grid = append(grid, scanner.Text())
+func init() {
+	aoc.Register(2025, 7, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	started := false
+	for sc.Scan() {
+		line := sc.Text()
+		for i := 0; i < len(line); i++ {
+			switch {
+			case line[i] == 'S' && !started:
+				started = true
+			case line[i] == 'S':
+				if err := sc.Bad(i+1, "a single start S", "S"); err != nil {
+					return err
+				}
+			case line[i] != '.' && line[i] != '^':
+				if err := sc.Bad(i+1, `".", "^" or "S"`, line[i:i+1]); err != nil {
+					return err
+				}
+			}
+		}
+		s.grid = append(s.grid, line)
+	}
+	if err := sc.Err(); err != nil {
+		return err
 	}
+	if !started {
+		return sc.Missing("start position S")
+	}
+	return nil
+}
 
-	splits := simulateBeams(grid)
-	fmt.Printf("Part 1 - Total splits: %d\n", splits)
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(simulateBeams(s.grid)), nil
+}
 
-	timelines := countTimelines(grid)
-	fmt.Printf("Part 2 - Total timelines: %d\n", timelines)
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(countTimelines(s.grid)), nil
 }
 
 func simulateBeams(grid []string) int {
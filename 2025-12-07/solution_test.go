@@ -0,0 +1,51 @@
+package day07
+
+import (
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "21", Part2: "40"},
+	})
+}
+
+func TestBeams(t *testing.T) {
+	tests := []struct {
+		name              string
+		grid              []string
+		splits, timelines int
+	}{
+		{"no start", []string{"...", "..."}, 0, 0},
+		{"straight down", []string{".S.", "...", "..."}, 0, 1},
+		{"one splitter", []string{".S.", ".^.", "..."}, 1, 2},
+		{"beams merge", []string{"..S..", "..^..", ".....", ".^.^.", "....."}, 3, 4},
+		{"split off the edge", []string{"S..", "^..", "..."}, 1, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := simulateBeams(tt.grid); got != tt.splits {
+				t.Errorf("simulateBeams = %d, want %d", got, tt.splits)
+			}
+			if got := countTimelines(tt.grid); got != tt.timelines {
+				t.Errorf("countTimelines = %d, want %d", got, tt.timelines)
+			}
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad cell", Input: ".S.\n.v.\n", Err: `line 2:2: expected ".", "^" or "S", got "v"`},
+		{Name: "two starts", Input: ".S.\n..S\n", Err: `line 2:3: expected a single start S, got "S"`},
+		{Name: "no start", Input: "...\n.^.\n", Err: `line 3: expected start position S, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 20)
+}
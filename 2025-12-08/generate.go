@@ -0,0 +1,24 @@
+package day08
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 8, aoc.Generator{Generate: generate, Size: 1000, Unit: "junction boxes"})
+}
+
+// generate writes size junction boxes at random positions in a cube 100,000
+// units wide; at least two, as the puzzle needs a pair to connect.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	for range max(size, 2) {
+		if _, err := fmt.Fprintf(w, "%d,%d,%d\n", rng.IntN(100_000), rng.IntN(100_000), rng.IntN(100_000)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+Day 8: Playground - Junction Box Circuit Problem
+
+Part 1: Connect 1000 closest pairs (10 for the example), find product of 3 largest circuits
+Part 2: Connect all boxes into one circuit, find product of last connection's X coordinates
+
+Algorithm: Kruskal's MST with Union-Find
+*/
+package day08
+
+import (
+	"context"
+	"embed"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+	"github.com/xinyun2020/advent-of-code/aoc/unionfind"
+)
+
+// Edge joins boxes i and j; dist is the squared distance between them,
+// which orders edges the same way as the distance itself.
+type Edge struct {
+	i, j int
+	dist int
+}
+
+func parseInput(r io.Reader) ([]geom.Point3, error) {
+	sc := parse.NewScanner(r)
+	points := []geom.Point3{}
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		xyz, err := sc.Ints(3, ",", "junction box X,Y,Z")
+		if err != nil {
+			return nil, err
+		}
+		if xyz != nil {
+			points = append(points, geom.Point3{X: xyz[0], Y: xyz[1], Z: xyz[2]})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(points) < 2 {
+		return nil, sc.Missing("at least two junction boxes")
+	}
+	return points, nil
+}
+
+func buildEdges(points []geom.Point3) []Edge {
+	n := len(points)
+	edges := make([]Edge, 0, n*(n-1)/2)
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			dist := points[i].DistSq(points[j])
+			edges = append(edges, Edge{i, j, dist})
+		}
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].dist < edges[j].dist
+	})
+
+	return edges
+}
+
+func solvePart1(points []geom.Point3, edges []Edge, pairs int) aoc.Answer {
+	n := len(points)
+	uf := unionfind.New(n)
+
+	processed := 0
+	connected := 0
+
+	for _, edge := range edges {
+		if processed >= pairs {
+			break
+		}
+		processed++
+		if uf.Union(edge.i, edge.j) {
+			connected++
+		}
+	}
+
+	sizes := uf.Sizes()
+	sort.Slice(sizes, func(i, j int) bool {
+		return sizes[i] > sizes[j]
+	})
+
+	product := 0
+	if len(sizes) >= 3 {
+		product = sizes[0] * sizes[1] * sizes[2]
+	}
+
+	return aoc.Int(product).
+		Notef("Processed %d pairs, made %d connections", processed, connected).
+		Notef("%d circuits remain", len(sizes)).
+		Notef("Top circuit sizes: %v", sizes[:min(5, len(sizes))])
+}
+
+func solvePart2(points []geom.Point3, edges []Edge) aoc.Answer {
+	n := len(points)
+	uf := unionfind.New(n)
+	var lastI, lastJ int
+
+	for _, edge := range edges {
+		if uf.Union(edge.i, edge.j) {
+			lastI, lastJ = edge.i, edge.j
+
+			if uf.Sets() == 1 {
+				break
+			}
+		}
+	}
+
+	return aoc.Int(points[lastI].X*points[lastJ].X).
+		Notef("Last connection joins boxes %d and %d", lastI, lastJ).
+		Notef("Box %d at (%d, %d, %d)", lastI, points[lastI].X, points[lastI].Y, points[lastI].Z).
+		Notef("Box %d at (%d, %d, %d)", lastJ, points[lastJ].X, points[lastJ].Y, points[lastJ].Z)
+}
+
+//go:embed samples
+var samples embed.FS
+
+// The puzzle connects the 1000 closest pairs of its 1000 boxes, but only
+// the 10 closest of its 20-box example.
+const (
+	inputPairs   = 1000
+	examplePairs = 10
+	exampleBoxes = 20
+)
+
+// pairsFor returns how many closest pairs part 1 connects for an input of
+// boxes junction boxes: the example's 10 for one no bigger than it.
+func pairsFor(boxes int) int {
+	if boxes <= exampleBoxes {
+		return examplePairs
+	}
+	return inputPairs
+}
+
+type solver struct {
+	pairs  int // closest pairs to connect in part 1, from pairsFor
+	points []geom.Point3
+	edges  []Edge
+}
+
+func init() {
+	aoc.Register(2025, 8, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	points, err := parseInput(r)
+	s.points = points
+	s.pairs = pairsFor(len(points))
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return solvePart1(s.points, s.sortedEdges(), s.pairs), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return solvePart2(s.points, s.sortedEdges()), nil
+}
+
+// sortedEdges builds the edge list on first use; both parts walk the same
+// edges in order of increasing distance.
+func (s *solver) sortedEdges() []Edge {
+	if s.edges == nil {
+		s.edges = buildEdges(s.points)
+	}
+	return s.edges
+}
@@ -0,0 +1,49 @@
+package day08
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+)
+
+func TestExamples(t *testing.T) {
+	// The example connects the 10 closest pairs instead of 1000.
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "40", Part2: "25272"},
+	})
+}
+
+func TestBuildEdgesSortedByDistance(t *testing.T) {
+	points := []geom.Point3{{X: 0}, {X: 10}, {X: 1}, {Y: 3, Z: 4}}
+	edges := buildEdges(points)
+	if len(edges) != 6 {
+		t.Fatalf("got %d edges, want 6", len(edges))
+	}
+	for i := 1; i < len(edges); i++ {
+		if edges[i].dist < edges[i-1].dist {
+			t.Fatalf("edges not sorted: %v", edges)
+		}
+	}
+	if e := edges[0]; e.i != 0 || e.j != 2 || e.dist != 1 {
+		t.Errorf("closest edge = %+v, want 0-2 at distance 1", e)
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "two fields", Input: "162,817,812\n57,618\n", Err: `line 2:1: expected junction box X,Y,Z, got "57,618"`},
+		{Name: "bad number", Input: "162,817,812\n57,6l8,57\n", Err: `line 2:4: expected integer, got "6l8"`},
+		{Name: "one box", Input: "162,817,812\n", Err: `line 2: expected at least two junction boxes, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	for _, size := range []int{1, 200} {
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, size)
+		})
+	}
+}
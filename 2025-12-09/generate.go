@@ -0,0 +1,71 @@
+package day09
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 9, aoc.Generator{Generate: generate, Size: 496, Unit: "red tiles"})
+}
+
+// generate writes a simple rectilinear polygon with about size corners,
+// listed in order around the boundary.
+//
+// The polygon is a row of size/4 adjacent columns, each spanning its own
+// range of y, where neighbouring columns overlap but never share a top or a
+// bottom. Walking along the tops and back along the bottoms then turns at
+// every column boundary, and the overlap keeps the outline from touching
+// itself. Coordinates stay below 100,000.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const limit = 100_000
+	columns := max(size/4, 1)
+
+	xs := distinct(rng, columns+1, limit)
+	lo, hi := make([]int, columns), make([]int, columns)
+	for i := range columns {
+		for {
+			a, b := rng.IntN(limit), rng.IntN(limit)
+			lo[i], hi[i] = min(a, b), max(a, b)
+			if i == 0 || lo[i] < hi[i] &&
+				lo[i] != lo[i-1] && hi[i] != hi[i-1] &&
+				max(lo[i], lo[i-1]) < min(hi[i], hi[i-1]) {
+				break
+			}
+		}
+	}
+
+	var corners []geom.Point
+	for i := range columns {
+		corners = append(corners, geom.Point{X: xs[i], Y: hi[i]}, geom.Point{X: xs[i+1], Y: hi[i]})
+	}
+	for i := columns - 1; i >= 0; i-- {
+		corners = append(corners, geom.Point{X: xs[i+1], Y: lo[i]}, geom.Point{X: xs[i], Y: lo[i]})
+	}
+
+	for _, p := range corners {
+		if _, err := fmt.Fprintf(w, "%d,%d\n", p.X, p.Y); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// distinct returns n sorted, distinct integers below limit.
+func distinct(rng *rand.Rand, n, limit int) []int {
+	seen := make(map[int]bool, n)
+	var nums []int
+	for len(nums) < n {
+		if v := rng.IntN(limit); !seen[v] {
+			seen[v] = true
+			nums = append(nums, v)
+		}
+	}
+	slices.Sort(nums)
+	return nums
+}
@@ -4,91 +4,103 @@ Day 9: Movie Theater - Largest Rectangle in Rectilinear Polygon
 Part 1: Find largest rectangle with red tiles (vertices) as corners
 Part 2: Find largest rectangle with corners on polygon boundary (red or green tiles)
 */
-package main
+package day09
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"context"
+	"embed"
+	"io"
 	"sort"
-	"strconv"
 	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
 )
 
-type Point struct{ x, y int }
 type HEdge struct{ y, x1, x2 int }
 type VEdge struct{ x, y1, y2 int }
 
-func main() {
-	filename := "input.txt"
-	if len(os.Args) > 1 {
-		filename = os.Args[1]
-	}
-	points, err := parseInput(filename)
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
-	}
+//go:embed samples
+var samples embed.FS
 
-	hEdges, vEdges := buildEdges(points)
-	xs, ys := collectCoordinates(points)
+type solver struct {
+	points []geom.Point
+}
 
-	fmt.Printf("Part 1: %d\n", solvePart1(points))
-	fmt.Printf("Part 2: %d\n", solvePart2(xs, ys, hEdges, vEdges, points))
+func init() {
+	aoc.Register(2025, 9, func() aoc.Solver { return &solver{} }, samples)
 }
 
-func parseInput(filename string) ([]Point, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+func (s *solver) Parse(r io.Reader) error {
+	points, err := parseInput(r)
+	s.points = points
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(solvePart1(s.points)), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	hEdges, vEdges := buildEdges(s.points)
+	xs, ys := collectCoordinates(s.points)
+	return solvePart2(ctx, xs, ys, hEdges, vEdges, s.points), nil
+}
 
-	var points []Point
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := strings.TrimSpace(scanner.Text())
-		if line == "" {
+func parseInput(r io.Reader) ([]geom.Point, error) {
+	sc := parse.NewScanner(r)
+	var points []geom.Point
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
 			continue
 		}
-		parts := strings.Split(line, ",")
-		if len(parts) == 2 {
-			x, _ := strconv.Atoi(strings.TrimSpace(parts[0]))
-			y, _ := strconv.Atoi(strings.TrimSpace(parts[1]))
-			points = append(points, Point{x, y})
+		xy, err := sc.Ints(2, ",", "red tile X,Y")
+		if err != nil {
+			return nil, err
+		}
+		if xy != nil {
+			points = append(points, geom.Point{X: xy[0], Y: xy[1]})
 		}
 	}
-	return points, scanner.Err()
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(points) < 2 {
+		return nil, sc.Missing("at least two red tiles")
+	}
+	return points, nil
 }
 
-func buildEdges(points []Point) ([]HEdge, []VEdge) {
+func buildEdges(points []geom.Point) ([]HEdge, []VEdge) {
 	var hEdges []HEdge
 	var vEdges []VEdge
 	n := len(points)
 	for i := 0; i < n; i++ {
 		p1, p2 := points[i], points[(i+1)%n]
-		if p1.y == p2.y {
-			x1, x2 := p1.x, p2.x
+		if p1.Y == p2.Y {
+			x1, x2 := p1.X, p2.X
 			if x1 > x2 {
 				x1, x2 = x2, x1
 			}
-			hEdges = append(hEdges, HEdge{p1.y, x1, x2})
+			hEdges = append(hEdges, HEdge{p1.Y, x1, x2})
 		} else {
-			y1, y2 := p1.y, p2.y
+			y1, y2 := p1.Y, p2.Y
 			if y1 > y2 {
 				y1, y2 = y2, y1
 			}
-			vEdges = append(vEdges, VEdge{p1.x, y1, y2})
+			vEdges = append(vEdges, VEdge{p1.X, y1, y2})
 		}
 	}
 	return hEdges, vEdges
 }
 
-func collectCoordinates(points []Point) ([]int, []int) {
+func collectCoordinates(points []geom.Point) ([]int, []int) {
 	xSet, ySet := make(map[int]bool), make(map[int]bool)
 	for _, p := range points {
-		xSet[p.x] = true
-		ySet[p.y] = true
+		xSet[p.X] = true
+		ySet[p.Y] = true
 	}
 	var xs, ys []int
 	for x := range xSet {
@@ -102,11 +114,11 @@ func collectCoordinates(points []Point) ([]int, []int) {
 	return xs, ys
 }
 
-func solvePart1(points []Point) int {
+func solvePart1(points []geom.Point) int {
 	maxArea := 0
 	for i := 0; i < len(points); i++ {
 		for j := i + 1; j < len(points); j++ {
-			area := rectArea(points[i].x, points[j].x, points[i].y, points[j].y)
+			area := rectArea(points[i].X, points[j].X, points[i].Y, points[j].Y)
 			if area > maxArea {
 				maxArea = area
 			}
@@ -115,7 +127,9 @@ func solvePart1(points []Point) int {
 	return maxArea
 }
 
-func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point) int {
+func solvePart2(ctx context.Context, xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []geom.Point) aoc.Answer {
+	log := logging.From(ctx)
+
 	// Build lookup for quick boundary checks
 	// For each y coordinate, store list of horizontal edges at that y
 	hEdgesByY := make(map[int][]HEdge)
@@ -159,7 +173,7 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	// Check if any vertex is STRICTLY inside the rectangle (not on edges or corners)
 	hasVertexStrictlyInside := func(minX, maxX, minY, maxY int) bool {
 		for _, v := range vertices {
-			if v.x > minX && v.x < maxX && v.y > minY && v.y < maxY {
+			if v.X > minX && v.X < maxX && v.Y > minY && v.Y < maxY {
 				return true
 			}
 		}
@@ -170,15 +184,15 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	hasVertexOnEdgeOrInside := func(minX, maxX, minY, maxY int) bool {
 		for _, v := range vertices {
 			// Strictly inside
-			if v.x > minX && v.x < maxX && v.y > minY && v.y < maxY {
+			if v.X > minX && v.X < maxX && v.Y > minY && v.Y < maxY {
 				return true
 			}
 			// On left or right edge (not at corners)
-			if (v.x == minX || v.x == maxX) && v.y > minY && v.y < maxY {
+			if (v.X == minX || v.X == maxX) && v.Y > minY && v.Y < maxY {
 				return true
 			}
 			// On top or bottom edge (not at corners)
-			if (v.y == minY || v.y == maxY) && v.x > minX && v.x < maxX {
+			if (v.Y == minY || v.Y == maxY) && v.X > minX && v.X < maxX {
 				return true
 			}
 		}
@@ -324,8 +338,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 			return false
 		}
 		// Only check corners are inside or on boundary
-		for _, pt := range []struct{ x, y int }{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}} {
-			if !isInsidePolygon(pt.x, pt.y) && !isOnBoundary(pt.x, pt.y) {
+		for _, pt := range corners(minX, maxX, minY, maxY) {
+			if !isInsidePolygon(pt.X, pt.Y) && !isOnBoundary(pt.X, pt.Y) {
 				return false
 			}
 		}
@@ -347,17 +361,16 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	}
 
 	// Version G: Corners must be GREEN (on boundary but NOT vertices)
-	vertexSet := make(map[Point]bool)
+	vertexSet := make(map[geom.Point]bool)
 	for _, v := range vertices {
 		vertexSet[v] = true
 	}
 	isGreenTile := func(x, y int) bool {
-		return isOnBoundary(x, y) && !vertexSet[Point{x, y}]
+		return isOnBoundary(x, y) && !vertexSet[geom.Point{X: x, Y: y}]
 	}
 	isValidRectG := func(minX, maxX, minY, maxY int) bool {
-		corners := []Point{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}}
-		for _, c := range corners {
-			if !isGreenTile(c.x, c.y) {
+		for _, c := range corners(minX, maxX, minY, maxY) {
+			if !isGreenTile(c.X, c.Y) {
 				return false
 			}
 		}
@@ -468,8 +481,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	// Version M: Corners can be INSIDE polygon (not just boundary) - no gap spanning
 	isValidRectM := func(minX, maxX, minY, maxY int) bool {
 		// Each corner must be inside OR on boundary
-		for _, pt := range []struct{ x, y int }{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}} {
-			if !isInsidePolygon(pt.x, pt.y) && !isOnBoundary(pt.x, pt.y) {
+		for _, pt := range corners(minX, maxX, minY, maxY) {
+			if !isInsidePolygon(pt.X, pt.Y) && !isOnBoundary(pt.X, pt.Y) {
 				return false
 			}
 		}
@@ -486,8 +499,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	// Version N: Corners can be INSIDE polygon (without gap check)
 	isValidRectN := func(minX, maxX, minY, maxY int) bool {
 		// Each corner must be inside OR on boundary
-		for _, pt := range []struct{ x, y int }{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}} {
-			if !isInsidePolygon(pt.x, pt.y) && !isOnBoundary(pt.x, pt.y) {
+		for _, pt := range corners(minX, maxX, minY, maxY) {
+			if !isInsidePolygon(pt.X, pt.Y) && !isOnBoundary(pt.X, pt.Y) {
 				return false
 			}
 		}
@@ -501,8 +514,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	// Version O: Like N but vertices on rectangle edges OK (not strictly inside)
 	isValidRectO := func(minX, maxX, minY, maxY int) bool {
 		// Each corner must be inside OR on boundary
-		for _, pt := range []struct{ x, y int }{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}} {
-			if !isInsidePolygon(pt.x, pt.y) && !isOnBoundary(pt.x, pt.y) {
+		for _, pt := range corners(minX, maxX, minY, maxY) {
+			if !isInsidePolygon(pt.X, pt.Y) && !isOnBoundary(pt.X, pt.Y) {
 				return false
 			}
 		}
@@ -515,8 +528,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 	xCoordSet := make(map[int]bool)
 	yCoordSet := make(map[int]bool)
 	for _, v := range vertices {
-		xCoordSet[v.x] = true
-		yCoordSet[v.y] = true
+		xCoordSet[v.X] = true
+		yCoordSet[v.Y] = true
 	}
 
 	// Version P: Corners at vertex coordinate grid points + no vertex strictly inside + center inside
@@ -613,8 +626,10 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 		return true
 	}
 
-	// Test all versions
-	_ = func() {
+	// At trace level, compare the answers of every validation version
+	// tried along the way. Each is a full search, so this is slow on real
+	// inputs.
+	if log.Enabled(logging.Trace) {
 		type candidate struct {
 			minX, minY, maxX, maxY, area int
 		}
@@ -638,142 +653,74 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 			return best
 		}
 
-		fmt.Println("\n=== Testing validation versions ===")
+		log.Tracef("Testing validation versions")
 		bestA := findMax(isValidRectA)
-		fmt.Printf("Version A (interior sweep check): %d\n", bestA.area)
+		log.Tracef("Version A (interior sweep check): %d", bestA.area)
 
 		bestB := findMax(isValidRectB)
-		fmt.Printf("Version B (no vertices on edges): %d\n", bestB.area)
+		log.Tracef("Version B (no vertices on edges): %d", bestB.area)
 
 		bestC := findMax(isValidRectC)
-		fmt.Printf("Version C (center only): %d\n", bestC.area)
+		log.Tracef("Version C (center only): %d", bestC.area)
 
 		bestD := findMax(isValidRectD)
-		fmt.Printf("Version D (edge sweep check): %d\n", bestD.area)
+		log.Tracef("Version D (edge sweep check): %d", bestD.area)
 
 		bestE := findMax(isValidRectE)
-		fmt.Printf("Version E (corners only): %d at (%d,%d)-(%d,%d)\n", bestE.area, bestE.minX, bestE.minY, bestE.maxX, bestE.maxY)
+		log.Tracef("Version E (corners only): %d at (%d,%d)-(%d,%d)", bestE.area, bestE.minX, bestE.minY, bestE.maxX, bestE.maxY)
 
 		bestF := findMax(isValidRectF)
-		fmt.Printf("Version F (no vertex on edges): %d at (%d,%d)-(%d,%d)\n", bestF.area, bestF.minX, bestF.minY, bestF.maxX, bestF.maxY)
+		log.Tracef("Version F (no vertex on edges): %d at (%d,%d)-(%d,%d)", bestF.area, bestF.minX, bestF.minY, bestF.maxX, bestF.maxY)
 
 		bestG := findMax(isValidRectG)
-		fmt.Printf("Version G (green corners only): %d at (%d,%d)-(%d,%d)\n", bestG.area, bestG.minX, bestG.minY, bestG.maxX, bestG.maxY)
+		log.Tracef("Version G (green corners only): %d at (%d,%d)-(%d,%d)", bestG.area, bestG.minX, bestG.minY, bestG.maxX, bestG.maxY)
 
 		bestH := findMax(isValidRectH)
-		fmt.Printf("Version H (edge boundary check): %d at (%d,%d)-(%d,%d)\n", bestH.area, bestH.minX, bestH.minY, bestH.maxX, bestH.maxY)
+		log.Tracef("Version H (edge boundary check): %d at (%d,%d)-(%d,%d)", bestH.area, bestH.minX, bestH.minY, bestH.maxX, bestH.maxY)
 
 		bestI := findMax(isValidRectI)
-		fmt.Printf("Version I (left/right edge check): %d at (%d,%d)-(%d,%d)\n", bestI.area, bestI.minX, bestI.minY, bestI.maxX, bestI.maxY)
+		log.Tracef("Version I (left/right edge check): %d at (%d,%d)-(%d,%d)", bestI.area, bestI.minX, bestI.minY, bestI.maxX, bestI.maxY)
 
 		bestJ := findMax(isValidRectJ)
-		fmt.Printf("Version J (no gap spanning): %d at (%d,%d)-(%d,%d)\n", bestJ.area, bestJ.minX, bestJ.minY, bestJ.maxX, bestJ.maxY)
+		log.Tracef("Version J (no gap spanning): %d at (%d,%d)-(%d,%d)", bestJ.area, bestJ.minX, bestJ.minY, bestJ.maxX, bestJ.maxY)
 
 		bestK := findMax(isValidRectK)
-		fmt.Printf("Version K (center on boundary OK): %d at (%d,%d)-(%d,%d)\n", bestK.area, bestK.minX, bestK.minY, bestK.maxX, bestK.maxY)
+		log.Tracef("Version K (center on boundary OK): %d at (%d,%d)-(%d,%d)", bestK.area, bestK.minX, bestK.minY, bestK.maxX, bestK.maxY)
 
 		bestL := findMax(isValidRectL)
-		fmt.Printf("Version L (edges on polygon): %d at (%d,%d)-(%d,%d)\n", bestL.area, bestL.minX, bestL.minY, bestL.maxX, bestL.maxY)
+		log.Tracef("Version L (edges on polygon): %d at (%d,%d)-(%d,%d)", bestL.area, bestL.minX, bestL.minY, bestL.maxX, bestL.maxY)
 
 		bestM := findMax(isValidRectM)
-		fmt.Printf("Version M (corners inside, no gap): %d at (%d,%d)-(%d,%d)\n", bestM.area, bestM.minX, bestM.minY, bestM.maxX, bestM.maxY)
+		log.Tracef("Version M (corners inside, no gap): %d at (%d,%d)-(%d,%d)", bestM.area, bestM.minX, bestM.minY, bestM.maxX, bestM.maxY)
 
 		bestN := findMax(isValidRectN)
-		fmt.Printf("Version N (corners inside, center check): %d at (%d,%d)-(%d,%d)\n", bestN.area, bestN.minX, bestN.minY, bestN.maxX, bestN.maxY)
+		log.Tracef("Version N (corners inside, center check): %d at (%d,%d)-(%d,%d)", bestN.area, bestN.minX, bestN.minY, bestN.maxX, bestN.maxY)
 
 		bestO := findMax(isValidRectO)
-		fmt.Printf("Version O (corners inside, no vertex check): %d at (%d,%d)-(%d,%d)\n", bestO.area, bestO.minX, bestO.minY, bestO.maxX, bestO.maxY)
+		log.Tracef("Version O (corners inside, no vertex check): %d at (%d,%d)-(%d,%d)", bestO.area, bestO.minX, bestO.minY, bestO.maxX, bestO.maxY)
 
 		bestP := findMax(isValidRectP)
-		fmt.Printf("Version P (grid points, center inside): %d at (%d,%d)-(%d,%d)\n", bestP.area, bestP.minX, bestP.minY, bestP.maxX, bestP.maxY)
+		log.Tracef("Version P (grid points, center inside): %d at (%d,%d)-(%d,%d)", bestP.area, bestP.minX, bestP.minY, bestP.maxX, bestP.maxY)
 
 		bestQ := findMax(isValidRectQ)
-		fmt.Printf("Version Q (grid, no gap): %d at (%d,%d)-(%d,%d)\n", bestQ.area, bestQ.minX, bestQ.minY, bestQ.maxX, bestQ.maxY)
+		log.Tracef("Version Q (grid, no gap): %d at (%d,%d)-(%d,%d)", bestQ.area, bestQ.minX, bestQ.minY, bestQ.maxX, bestQ.maxY)
 
 		bestR := findMax(isValidRectR)
-		fmt.Printf("Version R (grid, interior inside): %d at (%d,%d)-(%d,%d)\n", bestR.area, bestR.minX, bestR.minY, bestR.maxX, bestR.maxY)
-
-		fmt.Printf("\nExpected: 4516968960\n")
-
-		// Debug the exact expected answer rectangle
-		debugExpected := func(minX, minY, maxX, maxY int) {
-			fmt.Printf("\n=== Debug rectangle (%d,%d)-(%d,%d) ===\n", minX, minY, maxX, maxY)
-			fmt.Printf("  Area: %d (expected: 4516968960)\n", rectArea(minX, maxX, minY, maxY))
-
-			corners := []struct{ x, y int }{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}}
-			for _, c := range corners {
-				onBound := isOnBoundary(c.x, c.y)
-				inside := isInsidePolygon(c.x, c.y)
-				fmt.Printf("  Corner (%d,%d): onBoundary=%v, inside=%v\n", c.x, c.y, onBound, inside)
-
-				if !onBound {
-					// Check which edges are close
-					fmt.Printf("    Checking H-edges at y=%d:\n", c.y)
-					for _, e := range hEdgesByY[c.y] {
-						fmt.Printf("      H-edge y=%d, x=[%d,%d], contains=%v\n", e.y, e.x1, e.x2, c.x >= e.x1 && c.x <= e.x2)
-					}
-					fmt.Printf("    Checking V-edges at x=%d:\n", c.x)
-					for _, e := range vEdgesByX[c.x] {
-						fmt.Printf("      V-edge x=%d, y=[%d,%d], contains=%v\n", e.x, e.y1, e.y2, c.y >= e.y1 && c.y <= e.y2)
-					}
-				}
-			}
+		log.Tracef("Version R (grid, interior inside): %d at (%d,%d)-(%d,%d)", bestR.area, bestR.minX, bestR.minY, bestR.maxX, bestR.maxY)
 
-			fmt.Printf("  Vertex in rectangle interior: %v\n", hasVertexStrictlyInside(minX, maxX, minY, maxY))
-			midX, midY := (minX+maxX)/2, (minY+maxY)/2
-			fmt.Printf("  Center (%d,%d) inside polygon: %v\n", midX, midY, isInsidePolygon(midX, midY))
-		}
-		debugExpected(9983, 12591, 69997, 87854)
-
-		// Search for rectangles with exact dimensions 60014 x 75263 (area = 4516968960)
-		targetWidth := 60014  // x2 - x1
-		targetHeight := 75263 // y2 - y1
-		fmt.Printf("\n=== Searching for valid rectangles with exact expected dimensions ===\n")
-		fmt.Printf("Target: width=%d, height=%d, area=%d\n", targetWidth, targetHeight, (targetWidth+1)*(targetHeight+1))
-
-		// For each horizontal edge, check if we can form a valid rectangle
-		foundValid := 0
-		for _, minY := range ys {
-			maxY := minY + targetHeight
-			// Check if maxY is also a valid y-coordinate
-			for _, checkY := range ys {
-				if checkY == maxY {
-					// Found potential y-range, now search for x
-					for _, minX := range xs {
-						maxX := minX + targetWidth
-						// Check if corners are all on boundary
-						if isOnBoundary(minX, minY) && isOnBoundary(minX, maxY) &&
-							isOnBoundary(maxX, minY) && isOnBoundary(maxX, maxY) {
-							validA := isValidRectA(minX, maxX, minY, maxY)
-							validC := isValidRectC(minX, maxX, minY, maxY)
-							inside := hasVertexStrictlyInside(minX, maxX, minY, maxY)
-							midInside := isInsidePolygon((minX+maxX)/2, (minY+maxY)/2)
-							fmt.Printf("  (%d,%d)-(%d,%d): validA=%v, validC=%v, vertexInside=%v, midInside=%v\n",
-								minX, minY, maxX, maxY, validA, validC, inside, midInside)
-							foundValid++
-						}
-					}
-					break
-				}
-			}
-		}
-		if foundValid == 0 {
-			fmt.Printf("  No valid rectangles found with these exact dimensions\n")
-		}
 	}
 	// Version S: Part 2 - OPPOSITE corners must be RED (vertices), interior must be green/red (in polygon or on boundary)
 	isValidRectS := func(minX, maxX, minY, maxY int) bool {
 		// Two OPPOSITE corners MUST be actual vertices (red tiles)
 		// Check either diagonal: (minX,minY)&(maxX,maxY) OR (minX,maxY)&(maxX,minY)
-		diag1 := vertexSet[Point{minX, minY}] && vertexSet[Point{maxX, maxY}]
-		diag2 := vertexSet[Point{minX, maxY}] && vertexSet[Point{maxX, minY}]
+		diag1 := vertexSet[geom.Point{X: minX, Y: minY}] && vertexSet[geom.Point{X: maxX, Y: maxY}]
+		diag2 := vertexSet[geom.Point{X: minX, Y: maxY}] && vertexSet[geom.Point{X: maxX, Y: minY}]
 		if !diag1 && !diag2 {
 			return false
 		}
 		// All 4 corners must be red or green (on boundary OR inside polygon)
-		corners := []Point{{minX, minY}, {minX, maxY}, {maxX, minY}, {maxX, maxY}}
-		for _, c := range corners {
-			if !isOnBoundary(c.x, c.y) && !isInsidePolygon(c.x, c.y) {
+		for _, c := range corners(minX, maxX, minY, maxY) {
+			if !isOnBoundary(c.X, c.Y) && !isInsidePolygon(c.X, c.Y) {
 				return false
 			}
 		}
@@ -816,92 +763,8 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 		return true
 	}
 
-	// testVersions()
-
 	isValidRect := isValidRectS
 
-	// Debug: analyze the best candidate rectangle (17198,15350)-(82893,84807)
-	_ = func() {
-		minX, maxX, minY, maxY := 17198, 82893, 15350, 84807
-		fmt.Printf("\nDebug rect (%d,%d)-(%d,%d):\n", minX, minY, maxX, maxY)
-		fmt.Printf("  Area: %d\n", rectArea(minX, maxX, minY, maxY))
-
-		// Print vertices strictly inside
-		fmt.Printf("  Vertices strictly inside:\n")
-		for _, v := range vertices {
-			if v.x > minX && v.x < maxX && v.y > minY && v.y < maxY {
-				fmt.Printf("    (%d, %d)\n", v.x, v.y)
-			}
-		}
-
-		// Print vertices on edges (not corners)
-		fmt.Printf("  Vertices on left edge (x=%d):\n", minX)
-		for _, v := range vertices {
-			if v.x == minX && v.y > minY && v.y < maxY {
-				fmt.Printf("    (%d, %d)\n", v.x, v.y)
-			}
-		}
-		fmt.Printf("  Vertices on right edge (x=%d):\n", maxX)
-		for _, v := range vertices {
-			if v.x == maxX && v.y > minY && v.y < maxY {
-				fmt.Printf("    (%d, %d)\n", v.x, v.y)
-			}
-		}
-		fmt.Printf("  Vertices on bottom edge (y=%d):\n", minY)
-		for _, v := range vertices {
-			if v.y == minY && v.x > minX && v.x < maxX {
-				fmt.Printf("    (%d, %d)\n", v.x, v.y)
-			}
-		}
-		fmt.Printf("  Vertices on top edge (y=%d):\n", maxY)
-		for _, v := range vertices {
-			if v.y == maxY && v.x > minX && v.x < maxX {
-				fmt.Printf("    (%d, %d)\n", v.x, v.y)
-			}
-		}
-
-		// Print h-edges passing through interior
-		fmt.Printf("  H-edges passing through interior:\n")
-		for _, e := range hEdges {
-			if e.y > minY && e.y < maxY && e.x1 < maxX && e.x2 > minX {
-				fmt.Printf("    y=%d, x=[%d,%d]\n", e.y, e.x1, e.x2)
-			}
-		}
-
-		// Print v-edges passing through interior
-		fmt.Printf("  V-edges passing through interior:\n")
-		for _, e := range vEdges {
-			if e.x > minX && e.x < maxX && e.y1 < maxY && e.y2 > minY {
-				fmt.Printf("    x=%d, y=[%d,%d]\n", e.x, e.y1, e.y2)
-			}
-		}
-
-		// Sample points along edges to check if inside polygon
-		fmt.Printf("  Sampling left edge (x=%d):\n", minX)
-		yPoints := []int{minY, (minY + maxY) / 4, (minY + maxY) / 2, 3 * (minY + maxY) / 4, maxY, 48713, 50076}
-		for _, y := range yPoints {
-			inside := isInsidePolygon(minX, y)
-			onBoundary := isOnBoundary(minX, y)
-			fmt.Printf("    (%d,%d): inside=%v, onBoundary=%v\n", minX, y, inside, onBoundary)
-		}
-
-		fmt.Printf("  Sampling right edge (x=%d):\n", maxX)
-		for _, y := range yPoints {
-			inside := isInsidePolygon(maxX, y)
-			onBoundary := isOnBoundary(maxX, y)
-			fmt.Printf("    (%d,%d): inside=%v, onBoundary=%v\n", maxX, y, inside, onBoundary)
-		}
-
-		fmt.Printf("  Sampling horizontal center line (y=%d to %d):\n", 48500, 51000)
-		for y := 48500; y <= 51000; y += 250 {
-			insideLeft := isInsidePolygon(minX, y)
-			insideRight := isInsidePolygon(maxX, y)
-			insideMid := isInsidePolygon((minX+maxX)/2, y)
-			fmt.Printf("    y=%d: left=%v, mid=%v, right=%v\n", y, insideLeft, insideMid, insideRight)
-		}
-	}
-	// debugRect()
-
 	// Generate candidates efficiently: for each pair of vertices as opposite corners
 	type candidate struct {
 		minX, minY, maxX, maxY int
@@ -914,15 +777,15 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 		for j := i + 1; j < n; j++ {
 			v1, v2 := vertices[i], vertices[j]
 			// Skip if same x or same y (not a valid rectangle)
-			if v1.x == v2.x || v1.y == v2.y {
+			if v1.X == v2.X || v1.Y == v2.Y {
 				continue
 			}
 
-			minX, maxX := v1.x, v2.x
+			minX, maxX := v1.X, v2.X
 			if minX > maxX {
 				minX, maxX = maxX, minX
 			}
-			minY, maxY := v1.y, v2.y
+			minY, maxY := v1.Y, v2.Y
 			if minY > maxY {
 				minY, maxY = maxY, minY
 			}
@@ -956,18 +819,47 @@ func solvePart2(xs, ys []int, hEdges []HEdge, vEdges []VEdge, vertices []Point)
 			best = c
 		}
 	}
-	fmt.Printf("Best rect: (%d,%d)-(%d,%d), area=%d\n", best.minX, best.minY, best.maxX, best.maxY, best.area)
-	fmt.Printf("  Width: %d, Height: %d\n", best.maxX-best.minX+1, best.maxY-best.minY+1)
-	return maxArea
+	log.Debugf("%d candidate rectangles pass", len(candidates))
+	if log.Enabled(logging.Trace) {
+		minX, maxX, minY, maxY := best.minX, best.maxX, best.minY, best.maxY
+		log.Tracef("Best rect (%d,%d)-(%d,%d):", minX, minY, maxX, maxY)
+		for _, v := range vertices {
+			switch {
+			case v.X > minX && v.X < maxX && v.Y > minY && v.Y < maxY:
+				log.Tracef("  vertex (%d,%d) strictly inside", v.X, v.Y)
+			case (v.X == minX || v.X == maxX) && v.Y > minY && v.Y < maxY,
+				(v.Y == minY || v.Y == maxY) && v.X > minX && v.X < maxX:
+				log.Tracef("  vertex (%d,%d) on an edge", v.X, v.Y)
+			}
+		}
+		for _, e := range hEdges {
+			if e.y > minY && e.y < maxY && e.x1 < maxX && e.x2 > minX {
+				log.Tracef("  h-edge y=%d, x=[%d,%d] crosses the interior", e.y, e.x1, e.x2)
+			}
+		}
+		for _, e := range vEdges {
+			if e.x > minX && e.x < maxX && e.y1 < maxY && e.y2 > minY {
+				log.Tracef("  v-edge x=%d, y=[%d,%d] crosses the interior", e.x, e.y1, e.y2)
+			}
+		}
+		for _, c := range corners(minX, maxX, minY, maxY) {
+			log.Tracef("  corner (%d,%d): onBoundary=%v, inside=%v", c.X, c.Y, isOnBoundary(c.X, c.Y), isInsidePolygon(c.X, c.Y))
+		}
+	}
+
+	return aoc.Int(maxArea).
+		Notef("Best rect: (%d,%d)-(%d,%d), area=%d", best.minX, best.minY, best.maxX, best.maxY, best.area).
+		Notef("Width: %d, Height: %d", best.maxX-best.minX+1, best.maxY-best.minY+1)
 }
 
 func rectArea(x1, x2, y1, y2 int) int {
-	return (abs(x1-x2) + 1) * (abs(y1-y2) + 1)
+	return (geom.Abs(x1-x2) + 1) * (geom.Abs(y1-y2) + 1)
 }
 
-func abs(x int) int {
-	if x < 0 {
-		return -x
+// corners returns the four corners of a rectangle.
+func corners(minX, maxX, minY, maxY int) []geom.Point {
+	return []geom.Point{
+		{X: minX, Y: minY}, {X: minX, Y: maxY},
+		{X: maxX, Y: minY}, {X: maxX, Y: maxY},
 	}
-	return x
 }
@@ -0,0 +1,50 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "50", Part2: "24"},
+	})
+}
+
+func TestPart2Shapes(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []geom.Point
+		want   int
+	}{
+		// A plain rectangle is its own best answer.
+		{"rectangle", []geom.Point{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 2}, {X: 0, Y: 2}}, 15},
+		// An L shape: the full bounding box would cover the missing corner.
+		{"L shape", []geom.Point{{X: 0, Y: 0}, {X: 6, Y: 0}, {X: 6, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 6}, {X: 0, Y: 6}}, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hEdges, vEdges := buildEdges(tt.points)
+			xs, ys := collectCoordinates(tt.points)
+			got := solvePart2(t.Context(), xs, ys, hEdges, vEdges, tt.points)
+			if got.Value != aoc.Int(tt.want).Value {
+				t.Errorf("solvePart2 = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "three fields", Input: "7,1\n11,1,2\n", Err: `line 2:1: expected red tile X,Y, got "11,1,2"`},
+		{Name: "bad number", Input: "7,1\n11,-\n", Err: `line 2:4: expected integer, got "-"`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 24)
+}
@@ -0,0 +1,60 @@
+package day10
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 10, aoc.Generator{Generate: generate, Size: 160, Unit: "machines"})
+}
+
+// generate writes size machines with 4 to 10 lights each. The lights and
+// joltages are produced by pressing the machine's own buttons at random, so
+// every machine has a solution for both parts.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	for range size {
+		lights := 4 + rng.IntN(7)
+		m := Machine{
+			lights:  make([]int, lights),
+			joltage: make([]int, lights),
+			buttons: make([][]int, max(2, lights-2+rng.IntN(5))),
+		}
+		for i := range m.buttons {
+			m.buttons[i] = wiring(rng, lights)
+		}
+
+		for _, b := range m.buttons {
+			toggle := rng.IntN(2)
+			presses := rng.IntN(16)
+			for _, light := range b {
+				m.lights[light] ^= toggle
+				m.joltage[light] += presses
+			}
+		}
+
+		if _, err := fmt.Fprintln(w, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wiring returns the sorted lights one button toggles: at least one, and
+// never all of them.
+func wiring(rng *rand.Rand, lights int) []int {
+	for {
+		var b []int
+		for light := range lights {
+			if rng.IntN(3) == 0 {
+				b = append(b, light)
+			}
+		}
+		if len(b) > 0 && len(b) < lights {
+			return b
+		}
+	}
+}
@@ -0,0 +1,337 @@
+/*
+Day 10: Factory
+
+Part 1: GF(2) Gaussian elimination (binary field)
+Part 2: Integer Gaussian elimination with bounded free variable search
+*/
+package day10
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/linalg"
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+)
+
+type Machine struct {
+	lights  []int
+	joltage []int
+	buttons [][]int
+}
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	machines []Machine
+	workers  int
+}
+
+func init() {
+	aoc.Register(2025, 10, func() aoc.Solver { return &solver{workers: workpool.Default()} }, samples)
+}
+
+func (s *solver) SetWorkers(n int) { s.workers = n }
+
+func (s *solver) Parse(r io.Reader) error {
+	machines, err := parseInput(r)
+	s.machines = machines
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	presses := workpool.Map(s.workers, s.machines, func(_ int, m Machine) int {
+		return solvePart1(m)
+	})
+	log := logging.From(ctx)
+	for i, p := range presses {
+		log.Debugf("Machine %d: %d", i+1, p)
+	}
+	return aoc.Int(linalg.Sum(presses)), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	all := workpool.MapContext(ctx, s.workers, "machines", s.machines, func(_ int, m Machine) (int, error) {
+		return solvePart2(ctx, m)
+	})
+
+	log := logging.From(ctx)
+	total := 0
+	var notes []string
+	for i, o := range all {
+		switch {
+		case o.Err != nil:
+			notes = append(notes, fmt.Sprintf("Machine %d: %s", i+1, o.Undecided()))
+		case o.Value == -1:
+			notes = append(notes, fmt.Sprintf("Machine %d: 0 (no solution)", i+1))
+		default:
+			log.Debugf("Machine %d: %d", i+1, o.Value)
+			total += o.Value
+		}
+	}
+
+	answer := aoc.Int(total)
+	answer.Diagnostics = notes
+	return answer, workpool.Undecided(ctx, "machines", all)
+}
+
+// parseInput reads one machine per line:
+//
+//	[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}
+//
+// the indicator lights, the lights each button toggles, then the joltage
+// requirements, one per light.
+func parseInput(r io.Reader) ([]Machine, error) {
+	sc := parse.NewScanner(r)
+	var machines []Machine
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		m, err := parseMachine(sc)
+		if err != nil {
+			return nil, err
+		}
+		if m != nil {
+			machines = append(machines, *m)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(machines) == 0 {
+		return nil, sc.Missing("a machine")
+	}
+	return machines, nil
+}
+
+// parseMachine reads the scanner's current line. It returns nil, nil for a
+// line skipped by a lenient parse.
+func parseMachine(sc *parse.Scanner) (*Machine, error) {
+	fields := parse.Fields(sc.Text())
+	lights := fields[0]
+	if !enclosed(lights.Text, '[', ']') || len(lights.Text) < 3 {
+		return nil, sc.Bad(lights.Col, "indicator lights [.#...]", lights.Text)
+	}
+	m := &Machine{lights: make([]int, len(lights.Text)-2)}
+	for i, c := range lights.Text[1 : len(lights.Text)-1] {
+		switch c {
+		case '#':
+			m.lights[i] = 1
+		case '.':
+		default:
+			return nil, sc.Bad(lights.Col+1+i, `light "." or "#"`, string(c))
+		}
+	}
+
+	last := fields[len(fields)-1]
+	if len(fields) < 2 || !enclosed(last.Text, '{', '}') {
+		return nil, sc.Bad(last.Col+len(last.Text), "joltage requirements {...}", "")
+	}
+	for _, f := range fields[1 : len(fields)-1] {
+		if !enclosed(f.Text, '(', ')') {
+			return nil, sc.Bad(f.Col, "button wiring (...)", f.Text)
+		}
+		button, err := sc.ListInts(f.Inner(), len(m.lights), "light index")
+		if button == nil {
+			return nil, err
+		}
+		m.buttons = append(m.buttons, button)
+	}
+
+	joltage, err := sc.ListInts(last.Inner(), -1, "joltage")
+	if joltage == nil {
+		return nil, err
+	}
+	if len(joltage) != len(m.lights) {
+		return nil, sc.Bad(last.Col, fmt.Sprintf("%d joltage requirements", len(m.lights)), last.Text)
+	}
+	m.joltage = joltage
+	return m, nil
+}
+
+// String formats m as a line of puzzle input.
+func (m Machine) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for _, lit := range m.lights {
+		b.WriteByte(".#"[lit])
+	}
+	b.WriteByte(']')
+	for _, button := range m.buttons {
+		b.WriteString(" (" + joinInts(button) + ")")
+	}
+	b.WriteString(" {" + joinInts(m.joltage) + "}")
+	return b.String()
+}
+
+func joinInts(nums []int) string {
+	parts := make([]string, len(nums))
+	for i, n := range nums {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ",")
+}
+
+// enclosed reports whether s starts with open and ends with close.
+func enclosed(s string, open, close byte) bool {
+	return len(s) >= 2 && s[0] == open && s[len(s)-1] == close
+}
+
+// Part 1: GF(2) - binary field where 1+1=0
+func solvePart1(m Machine) int {
+	matrix := buildMatrix(m.buttons, len(m.lights), false)
+	return solveGF2(matrix, m.lights)
+}
+
+// Part 2: Integer linear system. Returns -1 if no combination of presses
+// works, or an error if ctx is done before the search finishes.
+func solvePart2(ctx context.Context, m Machine) (int, error) {
+	n, nButtons := len(m.joltage), len(m.buttons)
+	coeff := buildMatrix(m.buttons, n, true)
+	aug := linalg.Augment(coeff, m.joltage)
+	pivots := linalg.RREF(aug, nButtons)
+	if !linalg.Consistent(aug, pivots) {
+		return -1, nil
+	}
+	freeVars := linalg.FreeColumns(pivots, nButtons)
+
+	return searchMinSolution(ctx, aug, pivots, freeVars, nButtons, slices.Max(m.joltage))
+}
+
+// buildMatrix creates coefficient matrix from button mappings.
+// If additive=true, counts occurrences; if false, sets to 1 (binary).
+func buildMatrix(buttons [][]int, rows int, additive bool) [][]int {
+	matrix := make([][]int, rows)
+	for i := range matrix {
+		matrix[i] = make([]int, len(buttons))
+	}
+	for j, button := range buttons {
+		for _, idx := range button {
+			if idx < rows {
+				if additive {
+					matrix[idx][j]++
+				} else {
+					matrix[idx][j] = 1
+				}
+			}
+		}
+	}
+	return matrix
+}
+
+func searchMinSolution(ctx context.Context, aug [][]int, pivots, freeVars []int, nButtons, maxVal int) (int, error) {
+	minPresses := -1
+	assignment := make([]int, len(freeVars))
+	tried := 0
+	var err error
+
+	var search func(int)
+	search = func(idx int) {
+		if err != nil {
+			return
+		}
+		if idx == len(freeVars) {
+			if tried++; tried%workpool.CheckEvery == 0 && ctx.Err() != nil {
+				err = context.Cause(ctx)
+				return
+			}
+			solution := make([]int, nButtons)
+			for i, v := range freeVars {
+				solution[v] = assignment[i]
+			}
+			if backSubstitute(aug, pivots, solution, nButtons) {
+				if sum := linalg.Sum(solution); minPresses == -1 || sum < minPresses {
+					minPresses = sum
+				}
+			}
+			return
+		}
+
+		bound := maxVal
+		if minPresses != -1 {
+			bound = min(maxVal, minPresses-linalg.Sum(assignment[:idx])-1)
+		}
+
+		for val := 0; val <= bound; val++ {
+			assignment[idx] = val
+			search(idx + 1)
+		}
+	}
+
+	search(0)
+	return minPresses, err
+}
+
+func backSubstitute(aug [][]int, pivots []int, solution []int, nButtons int) bool {
+	for i, col := range pivots {
+		val := aug[i][nButtons]
+		for j := 0; j < nButtons; j++ {
+			if j != col {
+				val -= aug[i][j] * solution[j]
+			}
+		}
+
+		pivot := aug[i][col]
+		if pivot == 0 {
+			if val != 0 {
+				return false
+			}
+			continue
+		}
+		if val%pivot != 0 || val/pivot < 0 {
+			return false
+		}
+		solution[col] = val / pivot
+	}
+	return true
+}
+
+// GF(2) solver for Part 1
+func solveGF2(matrix [][]int, target []int) int {
+	if len(matrix) == 0 {
+		return 0
+	}
+
+	aug := linalg.Augment(matrix, target)
+	pivots := linalg.RREFGF2(aug)
+	if !linalg.Consistent(aug, pivots) {
+		return 0
+	}
+
+	return searchMinGF2(aug, pivots, linalg.FreeColumns(pivots, len(matrix[0])))
+}
+
+func searchMinGF2(aug [][]int, pivots, freeVars []int) int {
+	nCols := len(aug[0]) - 1
+	minPresses := nCols + 1
+
+	for mask := 0; mask < (1 << len(freeVars)); mask++ {
+		solution := make([]int, nCols)
+		for i, v := range freeVars {
+			solution[v] = (mask >> i) & 1
+		}
+
+		// Back substitution in GF(2)
+		for i := len(pivots) - 1; i >= 0; i-- {
+			col := pivots[i]
+			val := aug[i][nCols]
+			for j := col + 1; j < nCols; j++ {
+				val ^= aug[i][j] * solution[j]
+			}
+			solution[col] = val
+		}
+
+		minPresses = min(minPresses, linalg.Sum(solution))
+	}
+	return minPresses
+}
@@ -0,0 +1,243 @@
+package day10
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+	"github.com/xinyun2020/advent-of-code/aoc/difftest"
+	"github.com/xinyun2020/advent-of-code/aoc/linalg"
+)
+
+func TestExamples(t *testing.T) {
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "7", Part2: "33"},
+	})
+}
+
+func TestMachines(t *testing.T) {
+	tests := []struct {
+		line         string
+		part1, part2 int
+	}{
+		{"[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}", 2, 10},
+		{"[...#.] (0,2,3,4) (2,3) (0,4) (0,1,2) (1,2,3,4) {7,5,12,7,2}", 3, 12},
+		{"[.###.#] (0,1,2,3,4) (0,3,4) (0,1,2,4,5) (1,2) {10,11,11,5,10,5}", 2, 11},
+	}
+
+	for _, tt := range tests {
+		machines, err := parseInput(strings.NewReader(tt.line))
+		if err != nil || len(machines) != 1 {
+			t.Fatalf("parseInput(%q) = %v, %v", tt.line, machines, err)
+		}
+		if got := solvePart1(machines[0]); got != tt.part1 {
+			t.Errorf("solvePart1(%s) = %d, want %d", tt.line, got, tt.part1)
+		}
+		if got, err := solvePart2(t.Context(), machines[0]); err != nil || got != tt.part2 {
+			t.Errorf("solvePart2(%s) = %d, %v, want %d", tt.line, got, err, tt.part2)
+		}
+	}
+}
+
+func TestPart2Timeout(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(strings.NewReader("[.##.] (3) (1,3) (2) (2,3) (0,2) (0,1) {3,5,4,7}\n[.#] (0) (1) {1,1}\n")); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancelCause(t.Context())
+	cancel(errors.New("timed out"))
+
+	answer, err := s.Part2(ctx)
+	if err == nil || err.Error() != "2 of 2 machines undecided: timed out" {
+		t.Errorf("Part2 error = %v, want 2 of 2 machines undecided", err)
+	}
+	want := []string{"Machine 1: unknown", "Machine 2: unknown"}
+	if !slices.Equal(answer.Diagnostics, want) {
+		t.Errorf("Part2 diagnostics = %q, want %q", answer.Diagnostics, want)
+	}
+}
+
+func TestSolveGF2(t *testing.T) {
+	tests := []struct {
+		name   string
+		matrix [][]int
+		target []int
+		want   int
+	}{
+		{"already off", [][]int{{1, 0}, {0, 1}}, []int{0, 0}, 0},
+		{"one button each", [][]int{{1, 0}, {0, 1}}, []int{1, 1}, 2},
+		{"one button for both", [][]int{{1, 0, 1}, {0, 1, 1}}, []int{1, 1}, 1},
+		{"inconsistent", [][]int{{1}, {1}}, []int{1, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := solveGF2(tt.matrix, tt.target); got != tt.want {
+				t.Errorf("solveGF2 = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// Both parts must agree with trying every combination of presses on
+// machines small enough to enumerate. Random joltages are often out of
+// reach, which also checks that both report no solution alike.
+func TestAgainstOracle(t *testing.T) {
+	difftest.Run(t, difftest.Test[Machine, [2]int]{
+		Generate: randomMachine,
+		Shrink:   shrinkMachine,
+		Valid: func(m Machine) bool {
+			return len(m.lights) > 0 && len(m.buttons) > 0 &&
+				!slices.ContainsFunc(m.buttons, func(b []int) bool { return len(b) == 0 })
+		},
+		Oracle: func(m Machine) [2]int { return [2]int{exhaustivePart1(m), exhaustivePart2(m)} },
+		Fast: func(m Machine) [2]int {
+			presses, err := solvePart2(t.Context(), m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return [2]int{solvePart1(m), presses}
+		},
+		Format: Machine.String,
+	})
+}
+
+func randomMachine(rng *rand.Rand) Machine {
+	n := 1 + rng.IntN(4)
+	m := Machine{lights: make([]int, n), joltage: make([]int, n)}
+	for i := range n {
+		m.lights[i] = rng.IntN(2)
+		m.joltage[i] = rng.IntN(6)
+	}
+	for range 1 + rng.IntN(4) {
+		var button []int
+		for len(button) == 0 {
+			for light := range n {
+				if rng.IntN(2) == 0 {
+					button = append(button, light)
+				}
+			}
+		}
+		m.buttons = append(m.buttons, button)
+	}
+	return m
+}
+
+// shrinkMachine drops buttons or lights, turns lights off and lowers
+// joltages.
+func shrinkMachine(m Machine) []Machine {
+	var variants []Machine
+	for _, buttons := range difftest.ShrinkSlice(m.buttons, nil) {
+		variants = append(variants, Machine{lights: m.lights, joltage: m.joltage, buttons: buttons})
+	}
+	for light := range m.lights {
+		variants = append(variants, dropLight(m, light))
+	}
+	for i, lit := range m.lights {
+		if lit == 1 {
+			lights := slices.Clone(m.lights)
+			lights[i] = 0
+			variants = append(variants, Machine{lights: lights, joltage: m.joltage, buttons: m.buttons})
+		}
+	}
+	for _, joltage := range difftest.ShrinkSlice(m.joltage, difftest.ShrinkInt) {
+		if len(joltage) == len(m.joltage) {
+			variants = append(variants, Machine{lights: m.lights, joltage: joltage, buttons: m.buttons})
+		}
+	}
+	return variants
+}
+
+// dropLight removes one light, renumbering the lights after it.
+func dropLight(m Machine, light int) Machine {
+	out := Machine{
+		lights:  slices.Delete(slices.Clone(m.lights), light, light+1),
+		joltage: slices.Delete(slices.Clone(m.joltage), light, light+1),
+	}
+	for _, b := range m.buttons {
+		var button []int
+		for _, l := range b {
+			switch {
+			case l < light:
+				button = append(button, l)
+			case l > light:
+				button = append(button, l-1)
+			}
+		}
+		out.buttons = append(out.buttons, button)
+	}
+	return out
+}
+
+// exhaustivePart1 tries every set of buttons, returning 0 when none sets
+// the lights as solvePart1 does.
+func exhaustivePart1(m Machine) int {
+	best := -1
+	for mask := 0; mask < 1<<len(m.buttons); mask++ {
+		lights := make([]int, len(m.lights))
+		for j, b := range m.buttons {
+			if mask>>j&1 == 1 {
+				for _, l := range b {
+					lights[l] ^= 1
+				}
+			}
+		}
+		if slices.Equal(lights, m.lights) {
+			if presses := bits.OnesCount(uint(mask)); best == -1 || presses < best {
+				best = presses
+			}
+		}
+	}
+	return max(best, 0)
+}
+
+// exhaustivePart2 tries every count of presses up to the largest joltage
+// on every button, returning -1 when none reaches the joltages.
+func exhaustivePart2(m Machine) int {
+	limit := slices.Max(m.joltage)
+	presses := make([]int, len(m.buttons))
+	best := -1
+
+	var try func(j int)
+	try = func(j int) {
+		if j == len(presses) {
+			joltage := make([]int, len(m.joltage))
+			for k, b := range m.buttons {
+				for _, l := range b {
+					joltage[l] += presses[k]
+				}
+			}
+			if total := linalg.Sum(presses); slices.Equal(joltage, m.joltage) && (best == -1 || total < best) {
+				best = total
+			}
+			return
+		}
+		for p := 0; p <= limit; p++ {
+			presses[j] = p
+			try(j + 1)
+		}
+	}
+	try(0)
+	return best
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad light", Input: "[.#x.] (3) {3,5,4,7}\n", Err: `line 1:4: expected light "." or "#", got "x"`},
+		{Name: "no lights", Input: "(3) (1,3) {3,5,4,7}\n", Err: `line 1:1: expected indicator lights [.#...], got "(3)"`},
+		{Name: "unknown light", Input: "[.##.] (3) (1,4) {3,5,4,7}\n", Err: `line 1:15: expected light index below 4, got "4"`},
+		{Name: "bad button", Input: "[.##.] (3) 1,3 {3,5,4,7}\n", Err: `line 1:12: expected button wiring (...), got "1,3"`},
+		{Name: "truncated", Input: "[.##.] (3) (1,3)\n", Err: `line 1:17: expected joltage requirements {...}, got end of line`},
+		{Name: "joltage count", Input: "[.##.] (3) (1,3) {3,5,4}\n", Err: `line 1:18: expected 4 joltage requirements, got "{3,5,4}"`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 30)
+}
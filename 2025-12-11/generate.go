@@ -0,0 +1,115 @@
+package day11
+
+import (
+	"io"
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 11, aoc.Generator{Generate: generate, Size: 560, Unit: "devices"})
+}
+
+// generate writes an acyclic wiring of size devices. Devices are laid out
+// in a line from svr to out and only feed devices a short way further
+// along, so every device reaches out. Most devices have a single output,
+// which keeps the number of paths well inside an int. The lines are
+// written in random order.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const window = 15
+	size = max(size, 5)
+	names := deviceNames(rng, size)
+	names[0], names[size/10+1], names[size/3+1], names[2*size/3+1] = "svr", "you", "dac", "fft"
+	if rng.IntN(2) == 0 {
+		names[size/3+1], names[2*size/3+1] = "fft", "dac"
+	}
+
+	// outputs[i] holds indices of later devices; size stands for out.
+	outputs := make([][]int, size)
+	for i := range outputs {
+		degree := 1
+		if rng.IntN(4) == 0 {
+			degree = 2 + rng.IntN(2)
+		}
+		for range degree {
+			to := min(i+1+rng.IntN(window), size)
+			if !slices.Contains(outputs[i], to) {
+				outputs[i] = append(outputs[i], to)
+			}
+		}
+	}
+	// Make sure svr reaches the first of dac and fft and that reaches the
+	// second, so part 2 has paths to count.
+	for _, leg := range [][2]int{{0, size/3 + 1}, {size/3 + 1, 2*size/3 + 1}} {
+		from, to := leg[0], leg[1]
+		if last := lastReached(outputs, from, to); !slices.Contains(outputs[last], to) {
+			outputs[last] = append(outputs[last], to)
+		}
+	}
+
+	lines := make([]string, size)
+	for i, outs := range outputs {
+		targets := make([]string, len(outs))
+		for j, to := range outs {
+			targets[j] = "out"
+			if to < size {
+				targets[j] = names[to]
+			}
+		}
+		lines[i] = names[i] + ": " + strings.Join(targets, " ") + "\n"
+	}
+	rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	for _, line := range lines {
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lastReached returns the last device before to that from reaches, which
+// is from itself if it reaches nothing earlier.
+func lastReached(outputs [][]int, from, to int) int {
+	reached := make([]bool, to)
+	reached[from] = true
+	last := from
+	for i := from; i < to; i++ {
+		if !reached[i] {
+			continue
+		}
+		last = i
+		for _, next := range outputs[i] {
+			if next < to {
+				reached[next] = true
+			}
+		}
+	}
+	return last
+}
+
+// deviceNames returns n distinct names, none of which is one the puzzle
+// gives a meaning to. They have three letters like the real input's while
+// at most half of those are needed, and grow a letter at a time beyond, so
+// any size can be drawn without running out.
+func deviceNames(rng *rand.Rand, n int) []string {
+	length := 3
+	for space := 26 * 26 * 26; space < 2*n; space *= 26 {
+		length++
+	}
+	seen := map[string]bool{"svr": true, "you": true, "dac": true, "fft": true, "out": true}
+	names := make([]string, 0, n)
+	b := make([]byte, length)
+	for len(names) < n {
+		for i := range b {
+			b[i] = byte('a' + rng.IntN(26))
+		}
+		if name := string(b); !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
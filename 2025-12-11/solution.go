@@ -0,0 +1,88 @@
+/*
+Day 11: Reactor
+
+Part 1: Count all paths from "you" to "out" in a directed graph
+Part 2: Count paths from "svr" to "out" that visit both "dac" and "fft"
+*/
+package day11
+
+import (
+	"context"
+	"embed"
+	"io"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/graph"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// Graph maps each device to the devices its outputs feed.
+type Graph = graph.Digraph[string]
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	graph Graph
+}
+
+func init() {
+	aoc.Register(2025, 11, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	g, err := parseInput(r)
+	s.graph = g
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(s.graph.CountPaths("you", "out")), nil
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Int(s.graph.CountPaths("svr", "out", "dac", "fft")), nil
+}
+
+// parseInput reads one device per line, "name: output output ...".
+func parseInput(r io.Reader) (Graph, error) {
+	sc := parse.NewScanner(r)
+	g := make(Graph)
+
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		name, outputs, found := strings.Cut(line, ":")
+		from := strings.TrimSpace(name)
+		switch {
+		case !found:
+			if err := sc.BadLine("device NAME: OUTPUTS"); err != nil {
+				return nil, err
+			}
+			continue
+		case from == "" || strings.ContainsAny(from, " \t"):
+			if err := sc.Bad(1, "device name", name); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, dup := g[from]; dup {
+			if err := sc.Bad(1, "a device not listed before", from); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		g[from] = strings.Fields(outputs)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(g) == 0 {
+		return nil, sc.Missing("a device")
+	}
+	return g, nil
+}
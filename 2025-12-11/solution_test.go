@@ -0,0 +1,62 @@
+package day11
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	// Each part has its own example graph.
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "5"},
+		{Name: "example2", Part2: "2"},
+	})
+}
+
+func TestCountPaths(t *testing.T) {
+	g := Graph{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d", "e"},
+		"d": {"e"},
+	}
+
+	tests := []struct {
+		start, end string
+		required   []string
+		want       int
+	}{
+		{"a", "e", nil, 3},
+		{"a", "d", nil, 2},
+		{"a", "e", []string{"b"}, 1},
+		{"a", "e", []string{"c"}, 2},
+		{"a", "e", []string{"b", "c"}, 0},
+		{"e", "a", nil, 0},
+		{"x", "e", nil, 0},
+	}
+
+	for _, tt := range tests {
+		if got := g.CountPaths(tt.start, tt.end, tt.required...); got != tt.want {
+			t.Errorf("CountPaths(%s, %s, %v) = %d, want %d", tt.start, tt.end, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestMalformed(t *testing.T) {
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "no colon", Input: "aaa: you hhh\nyou bbb ccc\n", Err: `line 2:1: expected device NAME: OUTPUTS, got "you bbb ccc"`},
+		{Name: "no name", Input: ": you hhh\n", Err: `line 1:1: expected device name, got end of line`},
+		{Name: "listed twice", Input: "aaa: you\naaa: out\n", Err: `line 2:1: expected a device not listed before, got "aaa"`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	for _, size := range []int{100, 20000} {
+		t.Run(fmt.Sprintf("size %d", size), func(t *testing.T) {
+			aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, size)
+		})
+	}
+}
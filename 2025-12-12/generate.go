@@ -0,0 +1,61 @@
+package day12
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func init() {
+	aoc.RegisterGenerator(2025, 12, aoc.Generator{Generate: generate, Size: 1000, Unit: "regions"})
+}
+
+// generate writes six 3x3 present shapes followed by size regions between
+// 35 and 50 units a side. Like the real input, every region is easy to
+// decide: half have room to give each present its own 3x3 square, and the
+// rest ask for more cells than the region has.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const nShapes = 6
+	cells := make([]int, nShapes)
+	var b strings.Builder
+	for i := range nShapes {
+		fmt.Fprintf(&b, "%d:\n", i)
+		var shape [9]byte
+		for j := range shape {
+			shape[j] = '.'
+		}
+		cells[i] = 5 + rng.IntN(3)
+		for _, j := range rng.Perm(9)[:cells[i]] {
+			shape[j] = '#'
+		}
+		fmt.Fprintf(&b, "%s\n%s\n%s\n\n", shape[0:3], shape[3:6], shape[6:9])
+	}
+
+	for range size {
+		width, height := 35+rng.IntN(16), 35+rng.IntN(16)
+		counts := make([]int, nShapes)
+		if rng.IntN(2) == 0 {
+			// Fits: no more presents than there are 3x3 squares.
+			for range (width / 3) * (height / 3) * (80 + rng.IntN(21)) / 100 {
+				counts[rng.IntN(nShapes)]++
+			}
+		} else {
+			// Does not fit: keep adding presents until their cells overflow.
+			for used := 0; used <= width*height; {
+				shape := rng.IntN(nShapes)
+				counts[shape]++
+				used += cells[shape]
+			}
+		}
+		fmt.Fprintf(&b, "%dx%d:", width, height)
+		for _, c := range counts {
+			fmt.Fprintf(&b, " %d", c)
+		}
+		b.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
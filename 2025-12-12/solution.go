@@ -0,0 +1,418 @@
+/*
+Day 12: Christmas Tree Farm - Polyomino Tiling
+
+Fit present shapes (polyominoes) into rectangular regions under trees.
+Presents can be rotated and flipped. Solve using backtracking with pruning.
+*/
+package day12
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+)
+
+type Coord struct {
+	r, c int
+}
+
+type Shape []Coord
+
+// parseInput reads the numbered present shapes, each an "N:" line followed
+// by rows of '#' and '.', then one "WxH: COUNTS" line per region.
+func parseInput(r io.Reader) ([]Shape, []Region, error) {
+	sc := parse.NewScanner(r)
+	var shapes []Shape
+	var regions []Region
+	var currentShape Shape
+	currentShapeRow := 0
+	header, headerLine := "", 0 // the current shape's "N:" line, empty between shapes
+
+	closeShape := func() error {
+		if header == "" {
+			return nil
+		}
+		h := header
+		shapes = append(shapes, normalizeShape(currentShape))
+		currentShape, currentShapeRow, header = nil, 0, ""
+		if len(shapes[len(shapes)-1]) == 0 {
+			return sc.BadAt(headerLine, 1, "shape rows with at least one #", h)
+		}
+		return nil
+	}
+
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \t")
+
+		if line == "" {
+			if err := closeShape(); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		name, rest, isLabel := strings.Cut(line, ":")
+		switch {
+		case isLabel && strings.Contains(name, "x"):
+			if err := closeShape(); err != nil {
+				return nil, nil, err
+			}
+			region, err := parseRegion(sc, name, rest, len(shapes))
+			if err != nil {
+				return nil, nil, err
+			}
+			if region != nil {
+				regions = append(regions, *region)
+			}
+
+		case len(regions) > 0:
+			if err := sc.BadLine("region WxH: COUNTS"); err != nil {
+				return nil, nil, err
+			}
+
+		case isLabel:
+			if err := closeShape(); err != nil {
+				return nil, nil, err
+			}
+			if want := strconv.Itoa(len(shapes)); name != want || rest != "" {
+				if err := sc.BadLine(fmt.Sprintf("shape header %q", want+":")); err != nil {
+					return nil, nil, err
+				}
+			}
+			header, headerLine = line, sc.Line()
+
+		case header == "":
+			if err := sc.BadLine("shape header N: or region WxH: COUNTS"); err != nil {
+				return nil, nil, err
+			}
+
+		default:
+			for c, ch := range line {
+				switch ch {
+				case '#':
+					currentShape = append(currentShape, Coord{r: currentShapeRow, c: c})
+				case '.':
+				default:
+					if err := sc.Bad(c+1, `shape cell "#" or "."`, string(ch)); err != nil {
+						return nil, nil, err
+					}
+				}
+			}
+			currentShapeRow++
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, err
+	}
+	if err := closeShape(); err != nil {
+		return nil, nil, err
+	}
+	if len(regions) == 0 {
+		return nil, nil, sc.Missing("a region WxH: COUNTS")
+	}
+
+	return shapes, regions, nil
+}
+
+// parseRegion reads a "WxH: COUNTS" line, already cut at the colon. It
+// returns nil, nil for a line skipped by a lenient parse.
+func parseRegion(sc *parse.Scanner, dims, counts string, nShapes int) (*Region, error) {
+	size := parse.Split(dims, "x")
+	if len(size) != 2 {
+		return nil, sc.Bad(1, "region size WxH", dims)
+	}
+	var wh [2]int
+	for i, f := range size {
+		n, err := strconv.Atoi(f.Text)
+		if err != nil || n <= 0 {
+			return nil, sc.Bad(f.Col, "region size WxH", f.Text)
+		}
+		wh[i] = n
+	}
+
+	fields := parse.Fields(counts)
+	if len(fields) != nShapes {
+		return nil, sc.Bad(len(dims)+2, fmt.Sprintf("%d present counts", nShapes), strings.TrimSpace(counts))
+	}
+	region := &Region{width: wh[0], height: wh[1], counts: make([]int, nShapes)}
+	for i, f := range fields {
+		n, err := strconv.Atoi(f.Text)
+		if err != nil || n < 0 {
+			return nil, sc.Bad(len(dims)+1+f.Col, "present count", f.Text)
+		}
+		region.counts[i] = n
+	}
+	return region, nil
+}
+
+type Region struct {
+	width, height int
+	counts        []int
+}
+
+func normalizeShape(coords Shape) Shape {
+	if len(coords) == 0 {
+		return coords
+	}
+
+	minR, minC := coords[0].r, coords[0].c
+	for _, coord := range coords {
+		if coord.r < minR {
+			minR = coord.r
+		}
+		if coord.c < minC {
+			minC = coord.c
+		}
+	}
+
+	normalized := make(Shape, len(coords))
+	for i, coord := range coords {
+		normalized[i] = Coord{r: coord.r - minR, c: coord.c - minC}
+	}
+	return normalized
+}
+
+func rotate90(shape Shape) Shape {
+	rotated := make(Shape, len(shape))
+	for i, coord := range shape {
+		rotated[i] = Coord{r: coord.c, c: -coord.r}
+	}
+	return normalizeShape(rotated)
+}
+
+func flipHorizontal(shape Shape) Shape {
+	flipped := make(Shape, len(shape))
+	for i, coord := range shape {
+		flipped[i] = Coord{r: coord.r, c: -coord.c}
+	}
+	return normalizeShape(flipped)
+}
+
+func shapeKey(shape Shape) string {
+	var sb strings.Builder
+	for _, coord := range shape {
+		fmt.Fprintf(&sb, "(%d,%d)", coord.r, coord.c)
+	}
+	return sb.String()
+}
+
+func getAllOrientations(shape Shape) []Shape {
+	seen := make(map[string]bool)
+	var orientations []Shape
+
+	current := normalizeShape(shape)
+
+	for flip := 0; flip < 2; flip++ {
+		for rot := 0; rot < 4; rot++ {
+			key := shapeKey(current)
+			if !seen[key] {
+				seen[key] = true
+				orientations = append(orientations, current)
+			}
+			current = rotate90(current)
+		}
+		current = flipHorizontal(current)
+	}
+
+	return orientations
+}
+
+func canPlace(grid [][]byte, shape Shape, startR, startC, height, width int) bool {
+	for _, coord := range shape {
+		r, c := startR+coord.r, startC+coord.c
+		if r < 0 || r >= height || c < 0 || c >= width {
+			return false
+		}
+		if grid[r][c] != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func placeShape(grid [][]byte, shape Shape, startR, startC int, label byte) {
+	for _, coord := range shape {
+		r, c := startR+coord.r, startC+coord.c
+		grid[r][c] = label
+	}
+}
+
+func removeShape(grid [][]byte, shape Shape, startR, startC int) {
+	for _, coord := range shape {
+		r, c := startR+coord.r, startC+coord.c
+		grid[r][c] = '.'
+	}
+}
+
+type Present struct {
+	shapeIdx     int
+	orientations []Shape
+}
+
+func calculateArea(presents []Present, shapes []Shape, start int) int {
+	area := 0
+	for i := start; i < len(presents); i++ {
+		area += len(shapes[presents[i].shapeIdx])
+	}
+	return area
+}
+
+func countEmptySpace(grid [][]byte, height, width int) int {
+	count := 0
+	for r := 0; r < height; r++ {
+		for c := 0; c < width; c++ {
+			if grid[r][c] == '.' {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func createGrid(width, height int) [][]byte {
+	grid := make([][]byte, height)
+	for i := range grid {
+		grid[i] = make([]byte, width)
+		for j := range grid[i] {
+			grid[i][j] = '.'
+		}
+	}
+	return grid
+}
+
+// solveRegion reports whether the presents fit the region, or an error if
+// ctx is done before the search decides.
+func solveRegion(ctx context.Context, width, height int, presents []Present, shapes []Shape) (bool, error) {
+	// Quick feasibility check
+	if calculateArea(presents, shapes, 0) > width*height {
+		return false, nil
+	}
+
+	grid := createGrid(width, height)
+	tried := 0
+	var err error
+
+	var backtrack func(idx int) bool
+	backtrack = func(idx int) bool {
+		if idx == len(presents) {
+			return true
+		}
+		if tried++; tried%workpool.CheckEvery == 0 && ctx.Err() != nil {
+			err = context.Cause(ctx)
+		}
+		if err != nil {
+			return false
+		}
+
+		// Early termination - check if remaining area is sufficient
+		if calculateArea(presents, shapes, idx) > countEmptySpace(grid, height, width) {
+			return false
+		}
+
+		present := presents[idx]
+		label := byte('A' + (idx % 26))
+
+		for _, orientation := range present.orientations {
+			for r := 0; r < height; r++ {
+				for c := 0; c < width; c++ {
+					if canPlace(grid, orientation, r, c, height, width) {
+						placeShape(grid, orientation, r, c, label)
+						if backtrack(idx + 1) {
+							return true
+						}
+						removeShape(grid, orientation, r, c)
+					}
+				}
+			}
+		}
+
+		return false
+	}
+
+	fits := backtrack(0)
+	return fits, err
+}
+
+func solve(ctx context.Context, shapes []Shape, regions []Region, workers int) (aoc.Answer, error) {
+	// Precompute all orientations
+	allOrientations := make([][]Shape, len(shapes))
+	for i, shape := range shapes {
+		allOrientations[i] = getAllOrientations(shape)
+	}
+
+	outcomes := workpool.MapContext(ctx, workers, "regions", regions, func(i int, region Region) (bool, error) {
+		var presents []Present
+		for shapeIdx, quantity := range region.counts {
+			for j := 0; j < quantity; j++ {
+				presents = append(presents, Present{
+					shapeIdx:     shapeIdx,
+					orientations: allOrientations[shapeIdx],
+				})
+			}
+		}
+		return solveRegion(ctx, region.width, region.height, presents, shapes)
+	})
+
+	log := logging.From(ctx)
+	count := 0
+	var notes []string
+	for i, region := range regions {
+		verdict := "DOES NOT FIT"
+		switch o := outcomes[i]; {
+		case o.Err != nil:
+			verdict = strings.ToUpper(o.Undecided())
+		case o.Value:
+			verdict = "FITS"
+			count++
+		}
+		line := fmt.Sprintf("Region %d (%dx%d): %s", i+1, region.width, region.height, verdict)
+		if outcomes[i].Err != nil {
+			notes = append(notes, line)
+		} else {
+			log.Debugf("%s", line)
+		}
+	}
+	notes = append([]string{fmt.Sprintf("%d of %d regions fit", count, len(regions))}, notes...)
+
+	answer := aoc.Int(count)
+	answer.Diagnostics = notes
+	return answer, workpool.Undecided(ctx, "regions", outcomes)
+}
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	shapes  []Shape
+	regions []Region
+	workers int
+}
+
+func init() {
+	aoc.Register(2025, 12, func() aoc.Solver { return &solver{workers: workpool.Default()} }, samples)
+}
+
+func (s *solver) SetWorkers(n int) { s.workers = n }
+
+func (s *solver) Parse(r io.Reader) error {
+	shapes, regions, err := parseInput(r)
+	s.shapes, s.regions = shapes, regions
+	return err
+}
+
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return solve(ctx, s.shapes, s.regions, s.workers)
+}
+
+// Part2 has no puzzle; the second star is awarded for finishing the year.
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrNoPuzzle
+}
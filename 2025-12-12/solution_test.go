@@ -0,0 +1,124 @@
+package day12
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+// The example's third region does not fit, and proving that takes the
+// backtracking search several minutes, so the example is checked region by
+// region rather than through aoctest.
+func TestExampleRegions(t *testing.T) {
+	f, err := os.Open("samples/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	shapes, regions, err := parseInput(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shapes) != 6 || len(regions) != 3 {
+		t.Fatalf("parsed %d shapes and %d regions, want 6 and 3", len(shapes), len(regions))
+	}
+
+	for i, region := range regions[:2] {
+		presents := presentsFor(region, shapes)
+		if fits, err := solveRegion(t.Context(), region.width, region.height, presents, shapes); err != nil || !fits {
+			t.Errorf("region %d (%dx%d) fits = %v, %v, want true", i+1, region.width, region.height, fits, err)
+		}
+	}
+}
+
+func TestSolveRegion(t *testing.T) {
+	square := Shape{{0, 0}, {0, 1}, {1, 0}, {1, 1}}
+	bar := Shape{{0, 0}, {0, 1}, {0, 2}}
+	shapes := []Shape{square, bar}
+
+	tests := []struct {
+		name   string
+		region Region
+		want   bool
+	}{
+		{"exact fit", Region{width: 4, height: 2, counts: []int{2, 0}}, true},
+		{"too little area", Region{width: 3, height: 3, counts: []int{2, 0}}, false},
+		{"bar needs rotating", Region{width: 1, height: 3, counts: []int{0, 1}}, true},
+		{"enough area but wrong shape", Region{width: 2, height: 2, counts: []int{0, 1}}, false},
+		{"bars fill a square", Region{width: 3, height: 3, counts: []int{0, 3}}, true},
+		{"nothing to place", Region{width: 1, height: 1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presents := presentsFor(tt.region, shapes)
+			got, err := solveRegion(t.Context(), tt.region.width, tt.region.height, presents, shapes)
+			if err != nil || got != tt.want {
+				t.Errorf("solveRegion = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// The example's third region keeps the search busy for minutes, so a
+// short timeout must cut it off and leave the region undecided.
+func TestExampleTimeout(t *testing.T) {
+	f, err := os.Open("samples/example.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := &solver{}
+	if err := s.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeoutCause(t.Context(), 200*time.Millisecond, errors.New("timed out"))
+	defer cancel()
+	answer, err := s.Part1(ctx)
+	if err == nil || err.Error() != "1 of 3 regions undecided: timed out" {
+		t.Errorf("Part1 error = %v, want 1 of 3 regions undecided", err)
+	}
+	want := []string{"2 of 3 regions fit", "Region 3 (12x5): TIMED OUT"}
+	if !slices.Equal(answer.Diagnostics, want) {
+		t.Errorf("Part1 diagnostics = %q, want %q", answer.Diagnostics, want)
+	}
+}
+
+func presentsFor(region Region, shapes []Shape) []Present {
+	var presents []Present
+	for shapeIdx, quantity := range region.counts {
+		for j := 0; j < quantity; j++ {
+			presents = append(presents, Present{
+				shapeIdx:     shapeIdx,
+				orientations: getAllOrientations(shapes[shapeIdx]),
+			})
+		}
+	}
+	return presents
+}
+
+func TestMalformed(t *testing.T) {
+	shapes := "0:\n##\n#.\n\n1:\n.#\n##\n\n"
+	aoctest.RunMalformed(t, func() aoc.Solver { return &solver{} }, []aoctest.Malformed{
+		{Name: "bad size", Input: shapes + "4x4: 1 1\n4xfour: 1 1\n", Err: `line 10:3: expected region size WxH, got "four"`},
+		{Name: "no size", Input: shapes + "4x: 1 1\n", Err: `line 9:3: expected region size WxH, got end of line`},
+		{Name: "count mismatch", Input: shapes + "4x4: 1 1 1\n", Err: `line 9:5: expected 2 present counts, got "1 1 1"`},
+		{Name: "bad count", Input: shapes + "4x4: 1 -1\n", Err: `line 9:8: expected present count, got "-1"`},
+		{Name: "bad cell", Input: "0:\n#o\n", Err: `line 2:2: expected shape cell "#" or ".", got "o"`},
+		{Name: "shape out of order", Input: "1:\n##\n", Err: `line 1:1: expected shape header "0:", got "1:"`},
+		{Name: "empty shape", Input: "0:\n..\n\n4x4: 1\n", Err: `line 1:1: expected shape rows with at least one #, got "0:"`},
+		{Name: "no regions", Input: shapes, Err: `line 9: expected a region WxH: COUNTS, got end of input`},
+	})
+}
+
+func TestGenerate(t *testing.T) {
+	aoctest.RunGenerated(t, func() aoc.Solver { return &solver{} }, generate, 20)
+}
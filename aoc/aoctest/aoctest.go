@@ -0,0 +1,135 @@
+/*
+Package aoctest runs a day's solver against its example inputs in tests.
+
+Each Case names a fixture in the day's samples directory and the answers
+the puzzle text gives for it:
+
+	func TestExamples(t *testing.T) {
+		aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+			{Name: "example", Part1: "3", Part2: "6"},
+		})
+	}
+*/
+package aoctest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+// Case is one example input and its expected answers. An empty answer is
+// not checked, so a part can be filled in once the puzzle text reveals it.
+type Case struct {
+	Name         string // fixture read from samples/NAME.txt
+	Part1, Part2 string
+}
+
+// Run parses every case with a fresh solver and checks both parts.
+func Run(t *testing.T, newSolver func() aoc.Solver, cases []Case) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if tc.Part1 == "" && tc.Part2 == "" {
+				t.Skip("no expected answers yet")
+			}
+
+			f, err := os.Open(filepath.Join("samples", tc.Name+".txt"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			solver := newSolver()
+			if err := solver.Parse(f); err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			check(t, "Part1", solver.Part1, tc.Part1)
+			check(t, "Part2", solver.Part2, tc.Part2)
+		})
+	}
+}
+
+// Malformed is an input that Parse must reject, and the error it should
+// give for it.
+type Malformed struct {
+	Name  string
+	Input string
+	Err   string // e.g. `line 2:1: expected direction L or R, got "X"`
+}
+
+// RunMalformed checks that Parse fails on every case with the given error.
+func RunMalformed(t *testing.T, newSolver func() aoc.Solver, cases []Malformed) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := newSolver().Parse(strings.NewReader(tc.Input))
+			if err == nil {
+				t.Fatalf("Parse accepted %q, want error %s", tc.Input, tc.Err)
+			}
+			if err.Error() != tc.Err {
+				t.Errorf("Parse error = %s\nwant %s", err, tc.Err)
+			}
+		})
+	}
+}
+
+// RunGenerated feeds the solver inputs of the given size from a day's
+// generator, one per seed, and checks that each parses strictly and that
+// neither part fails. It also checks that a seed reproduces its input.
+func RunGenerated(t *testing.T, newSolver func() aoc.Solver, generate func(io.Writer, int, *rand.Rand) error, size int) {
+	t.Helper()
+	for seed := uint64(1); seed <= 3; seed++ {
+		t.Run(fmt.Sprintf("seed %d", seed), func(t *testing.T) {
+			var input, again bytes.Buffer
+			if err := generate(&input, size, rand.New(rand.NewPCG(seed, 0))); err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+			if err := generate(&again, size, rand.New(rand.NewPCG(seed, 0))); err != nil {
+				t.Fatalf("generate: %v", err)
+			}
+			if !bytes.Equal(input.Bytes(), again.Bytes()) {
+				t.Fatal("the same seed generated two different inputs")
+			}
+
+			solver := newSolver()
+			if err := solver.Parse(&input); err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if _, err := solver.Part1(t.Context()); err != nil && !errors.Is(err, aoc.ErrUnsolved) {
+				t.Errorf("Part1: %v", err)
+			}
+			if _, err := solver.Part2(t.Context()); err != nil && !errors.Is(err, aoc.ErrNoPuzzle) && !errors.Is(err, aoc.ErrUnsolved) {
+				t.Errorf("Part2: %v", err)
+			}
+		})
+	}
+}
+
+func check(t *testing.T, name string, part func(context.Context) (aoc.Answer, error), want string) {
+	t.Helper()
+	if want == "" {
+		return
+	}
+	got, err := part(t.Context())
+	if errors.Is(err, aoc.ErrUnsolved) {
+		t.Errorf("%s: not solved yet, want %s", name, want)
+		return
+	}
+	if err != nil {
+		t.Errorf("%s: %v", name, err)
+		return
+	}
+	if got.Value != want {
+		t.Errorf("%s = %s, want %s", name, got.Value, want)
+	}
+}
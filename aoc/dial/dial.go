@@ -0,0 +1,172 @@
+/*
+Package dial simulates a combination-lock dial: positions 0..size-1 around
+a circle, turned left (towards lower numbers) or right by some number of
+clicks, wrapping past 0 and size-1.
+
+A dial watches a set of positions and counts, for each, how many turns
+ended on it and how many times a turn went past it. Starts and Shortest
+run the simulation backwards, from the counts wanted to the start
+position or the turns that give them.
+*/
+package dial
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Direction is the way a dial turns.
+type Direction byte
+
+const (
+	Left  Direction = 'L' // towards lower numbers
+	Right Direction = 'R' // towards higher numbers
+)
+
+// Turn is one rotation of a dial, written like "L68".
+type Turn struct {
+	Dir    Direction
+	Clicks int
+}
+
+func (t Turn) String() string {
+	return fmt.Sprintf("%c%d", t.Dir, t.Clicks)
+}
+
+// Count is what a dial saw of one watched position.
+type Count struct {
+	Position int
+	Lands    int // turns that ended on the position
+	Passes   int // clicks onto the position in the middle of a turn
+}
+
+// Hits returns every click that left the dial on the position, whether
+// or not the turn stopped there.
+func (c Count) Hits() int {
+	return c.Lands + c.Passes
+}
+
+// Dial is a dial being turned, with counts for its watched positions.
+type Dial struct {
+	size     int
+	position int
+	counts   []Count // by position
+}
+
+// New returns a dial of size positions pointing at start and watching the
+// given positions. It panics if size is not positive or any position is
+// off the dial.
+func New(size, start int, watch ...int) *Dial {
+	if size <= 0 {
+		panic(fmt.Sprintf("dial: size %d is not positive", size))
+	}
+	d := &Dial{size: size}
+	d.position = d.check(start)
+
+	watch = slices.Clone(watch)
+	slices.Sort(watch)
+	for _, p := range slices.Compact(watch) {
+		d.counts = append(d.counts, Count{Position: d.check(p)})
+	}
+	return d
+}
+
+func (d *Dial) check(p int) int {
+	if p < 0 || p >= d.size {
+		panic(fmt.Sprintf("dial: position %d is off a dial of %d", p, d.size))
+	}
+	return p
+}
+
+// Size returns the number of positions on the dial.
+func (d *Dial) Size() int {
+	return d.size
+}
+
+// Position returns where the dial points.
+func (d *Dial) Position() int {
+	return d.position
+}
+
+// Turn turns the dial clicks positions in dir and updates the counts of
+// every watched position. A negative number of clicks turns the other way.
+func (d *Dial) Turn(dir Direction, clicks int) {
+	if dir != Left && dir != Right {
+		panic(fmt.Sprintf("dial: unknown direction %q", byte(dir)))
+	}
+	if clicks < 0 {
+		dir, clicks = opposite(dir), -clicks
+	}
+
+	end := d.position + clicks
+	if dir == Left {
+		end = d.position - clicks
+	}
+	end = mod(end, d.size)
+
+	for i := range d.counts {
+		c := &d.counts[i]
+		hits := d.hits(dir, clicks, c.Position)
+		if end == c.Position {
+			c.Lands++
+			if hits > 0 {
+				hits--
+			}
+		}
+		c.Passes += hits
+	}
+	d.position = end
+}
+
+// hits counts the clicks of a turn that leave the dial on p, including
+// its last.
+func (d *Dial) hits(dir Direction, clicks, p int) int {
+	// first is how many clicks it takes to reach p; a full turn if the
+	// dial is already there.
+	first := mod(p-d.position, d.size)
+	if dir == Left {
+		first = mod(d.position-p, d.size)
+	}
+	if first == 0 {
+		first = d.size
+	}
+	if clicks < first {
+		return 0
+	}
+	return 1 + (clicks-first)/d.size
+}
+
+// Apply makes each turn in order.
+func (d *Dial) Apply(turns ...Turn) {
+	for _, t := range turns {
+		d.Turn(t.Dir, t.Clicks)
+	}
+}
+
+// Count returns the counts of a watched position. It panics if p is not
+// watched.
+func (d *Dial) Count(p int) Count {
+	i, ok := slices.BinarySearchFunc(d.counts, p, func(c Count, p int) int {
+		return c.Position - p
+	})
+	if !ok {
+		panic(fmt.Sprintf("dial: position %d is not watched", p))
+	}
+	return d.counts[i]
+}
+
+// Counts returns the counts of every watched position, in position order.
+func (d *Dial) Counts() []Count {
+	return slices.Clone(d.counts)
+}
+
+func opposite(dir Direction) Direction {
+	if dir == Left {
+		return Right
+	}
+	return Left
+}
+
+func mod(a, n int) int {
+	return ((a % n) + n) % n
+}
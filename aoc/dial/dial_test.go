@@ -0,0 +1,113 @@
+package dial
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestTurn(t *testing.T) {
+	d := New(100, 50, 0, 99)
+	d.Turn(Left, 68)  // 82, passing 0 and 99
+	d.Turn(Left, 30)  // 52
+	d.Turn(Right, 48) // 0, passing 99
+	d.Turn(Left, 5)   // 95, passing 99
+	d.Turn(Right, 60) // 55, passing 99 and 0
+	d.Turn(Left, 55)  // 0
+	d.Turn(Left, 1)   // 99
+	d.Turn(Left, 99)  // 0
+	d.Turn(Right, 14) // 14
+	d.Turn(Left, 82)  // 32, passing 0 and 99
+
+	if got := d.Position(); got != 32 {
+		t.Errorf("Position = %d, want 32", got)
+	}
+	want := []Count{
+		{Position: 0, Lands: 3, Passes: 3},
+		{Position: 99, Lands: 1, Passes: 5},
+	}
+	if got := d.Counts(); !slices.Equal(got, want) {
+		t.Errorf("Counts = %v, want %v", got, want)
+	}
+	if got := d.Count(0).Hits(); got != 6 {
+		t.Errorf("Count(0).Hits = %d, want 6", got)
+	}
+}
+
+func TestFullTurns(t *testing.T) {
+	d := New(10, 0, 0, 5)
+	d.Turn(Right, 30)
+	if got := d.Count(0); got.Lands != 1 || got.Passes != 2 {
+		t.Errorf("from 0, R30: Count(0) = %+v, want 1 land, 2 passes", got)
+	}
+	if got := d.Count(5); got.Lands != 0 || got.Passes != 3 {
+		t.Errorf("from 0, R30: Count(5) = %+v, want 3 passes", got)
+	}
+
+	d.Turn(Right, -25) // Left 25: to 5
+	if got := d.Position(); got != 5 {
+		t.Errorf("Position = %d, want 5", got)
+	}
+	if got := d.Count(5); got.Lands != 1 || got.Passes != 5 {
+		t.Errorf("after L25: Count(5) = %+v, want 1 land, 5 passes", got)
+	}
+}
+
+// TestAgainstClicks compares Turn with moving one click at a time.
+func TestAgainstClicks(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for range 200 {
+		size := 1 + rng.IntN(12)
+		start := rng.IntN(size)
+		watch := []int{rng.IntN(size), rng.IntN(size), rng.IntN(size)}
+		d := New(size, start, watch...)
+
+		position := start
+		lands := make(map[int]int)
+		passes := make(map[int]int)
+		for range 20 {
+			dir, step := Left, -1
+			if rng.IntN(2) == 0 {
+				dir, step = Right, 1
+			}
+			clicks := rng.IntN(4 * size)
+			d.Turn(dir, clicks)
+
+			for k := 1; k <= clicks; k++ {
+				position = mod(position+step, size)
+				if k < clicks {
+					passes[position]++
+				}
+			}
+			lands[position]++
+		}
+
+		if d.Position() != position {
+			t.Fatalf("size %d from %d: Position = %d, want %d", size, start, d.Position(), position)
+		}
+		for _, c := range d.Counts() {
+			if c.Lands != lands[c.Position] || c.Passes != passes[c.Position] {
+				t.Fatalf("size %d from %d: Count(%d) = %+v, want %d lands, %d passes",
+					size, start, c.Position, c, lands[c.Position], passes[c.Position])
+			}
+		}
+	}
+}
+
+func TestNewPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"zero size":        func() { New(0, 0) },
+		"start off dial":   func() { New(10, 10) },
+		"watched off dial": func() { New(10, 0, -1) },
+		"unwatched count":  func() { New(10, 0, 1).Count(2) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("did not panic")
+				}
+			}()
+			f()
+		})
+	}
+}
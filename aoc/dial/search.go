@@ -0,0 +1,73 @@
+package dial
+
+import "fmt"
+
+// Starts returns, in order, every start position from which turns leave
+// the counts of target satisfying match, e.g. every start whose Hits on 0
+// come to a given password.
+func Starts(size, target int, turns []Turn, match func(Count) bool) []int {
+	var starts []int
+	for start := range size {
+		d := New(size, start, target)
+		d.Apply(turns...)
+		if match(d.Count(target)) {
+			starts = append(starts, start)
+		}
+	}
+	return starts
+}
+
+// Shortest returns a shortest list of turns that, from start, ends on
+// target exactly lands times and passes it exactly passes times, using
+// the fewest clicks among such lists. Every turn moves the dial at least
+// one click.
+//
+// A turn ends on target at most once, so lands turns are needed, or one
+// if there are only passes to make; any number of passes fit into a
+// single turn as extra full circles.
+func Shortest(size, start, target, lands, passes int) ([]Turn, error) {
+	switch {
+	case size <= 0:
+		return nil, fmt.Errorf("dial: size %d is not positive", size)
+	case start < 0 || start >= size:
+		return nil, fmt.Errorf("dial: start %d is off a dial of %d", start, size)
+	case target < 0 || target >= size:
+		return nil, fmt.Errorf("dial: target %d is off a dial of %d", target, size)
+	case lands < 0 || passes < 0:
+		return nil, fmt.Errorf("dial: %d lands and %d passes: counts cannot be negative", lands, passes)
+	case lands == 0 && passes == 0:
+		return nil, nil
+	case lands == 0 && size == 1:
+		return nil, fmt.Errorf("dial: on a dial of 1, every turn ends on %d", target)
+	}
+
+	// Clicks from start to the first visit of target either way; a full
+	// circle if the dial already points there.
+	right := mod(target-start, size)
+	left := mod(start-target, size)
+	if right == 0 {
+		right, left = size, size
+	}
+
+	var first Turn
+	if lands == 0 {
+		// Go past target passes times and stop one click beyond it.
+		first = Turn{Right, right + (passes-1)*size + 1}
+		if left < right {
+			first = Turn{Left, left + (passes-1)*size + 1}
+		}
+		return []Turn{first}, nil
+	}
+
+	// Make every pass on the way to the first landing, then come round
+	// to target once per remaining landing.
+	first = Turn{Right, right + passes*size}
+	if left < right {
+		first = Turn{Left, left + passes*size}
+	}
+	turns := []Turn{first}
+	for range lands - 1 {
+		turns = append(turns, Turn{Right, size})
+	}
+	return turns, nil
+}
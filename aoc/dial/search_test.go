@@ -0,0 +1,149 @@
+package dial
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+var exampleTurns = []Turn{
+	{Left, 68}, {Left, 30}, {Right, 48}, {Left, 5}, {Right, 60},
+	{Left, 55}, {Left, 1}, {Left, 99}, {Right, 14}, {Left, 82},
+}
+
+func ExampleStarts() {
+	// Which start positions give the day 1 example the same password?
+	starts := Starts(100, 0, exampleTurns, func(c Count) bool { return c.Hits() == 6 })
+	fmt.Println(len(starts), slices.Contains(starts, 50))
+	// Output: 20 true
+}
+
+func ExampleShortest() {
+	// From 50, the fewest turns that stop on 0 three times and pass it
+	// twice more.
+	turns, _ := Shortest(100, 50, 0, 3, 2)
+	fmt.Println(turns)
+	// Output: [R250 R100 R100]
+}
+
+func TestStarts(t *testing.T) {
+	for target := range 5 {
+		for want := range 4 {
+			starts := Starts(5, target, []Turn{{Right, 7}, {Left, 3}}, func(c Count) bool { return c.Lands+c.Passes == want })
+			for start := range 5 {
+				d := New(5, start, target)
+				d.Apply(Turn{Right, 7}, Turn{Left, 3})
+				if got := d.Count(target).Hits() == want; got != slices.Contains(starts, start) {
+					t.Errorf("target %d, %d hits: Starts = %v disagrees about %d", target, want, starts, start)
+				}
+			}
+		}
+	}
+}
+
+// TestShortest checks every small case against a search for the fewest
+// turns, then the fewest clicks, over dials of up to four positions.
+func TestShortest(t *testing.T) {
+	for size := 1; size <= 4; size++ {
+		for start := range size {
+			for target := range size {
+				for lands := range 3 {
+					for passes := range 3 {
+						turns, err := Shortest(size, start, target, lands, passes)
+						best, ok := searchShortest(size, start, target, lands, passes)
+						name := fmt.Sprintf("size %d, %d to %d, %d lands, %d passes", size, start, target, lands, passes)
+						if !ok {
+							if err == nil {
+								t.Errorf("%s: Shortest = %v, want an error", name, turns)
+							}
+							continue
+						}
+						if err != nil {
+							t.Errorf("%s: %v", name, err)
+							continue
+						}
+
+						d := New(size, start, target)
+						d.Apply(turns...)
+						if c := d.Count(target); c.Lands != lands || c.Passes != passes {
+							t.Errorf("%s: %v gives %+v", name, turns, c)
+						}
+						if got := cost(turns); got != best {
+							t.Errorf("%s: %v costs %v, want %v", name, turns, got, best)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestShortestOffTheDial(t *testing.T) {
+	for _, tc := range []struct{ size, start, target int }{
+		{0, 0, 0},
+		{-4, 0, 0},
+		{4, 4, 0},
+		{4, -1, 0},
+		{4, 0, 4},
+		{4, 0, -1},
+	} {
+		if turns, err := Shortest(tc.size, tc.start, tc.target, 1, 0); err == nil {
+			t.Errorf("Shortest(%d, %d, %d, 1, 0) = %v, want an error", tc.size, tc.start, tc.target, turns)
+		}
+	}
+}
+
+// turnsAndClicks orders lists of turns by length, then by clicks.
+type turnsAndClicks struct{ turns, clicks int }
+
+func (a turnsAndClicks) less(b turnsAndClicks) bool {
+	return a.turns < b.turns || (a.turns == b.turns && a.clicks < b.clicks)
+}
+
+func cost(turns []Turn) turnsAndClicks {
+	c := turnsAndClicks{turns: len(turns)}
+	for _, t := range turns {
+		c.clicks += t.Clicks
+	}
+	return c
+}
+
+// searchShortest finds the cheapest way to the counts by Dijkstra over
+// (position, lands, passes), with turns of up to a few full circles.
+func searchShortest(size, start, target, lands, passes int) (turnsAndClicks, bool) {
+	type state struct{ position, lands, passes int }
+	dist := map[state]turnsAndClicks{{start, 0, 0}: {}}
+	done := map[state]bool{}
+	goal := func(s state) bool { return s.lands == lands && s.passes == passes }
+	for {
+		var cur state
+		found := false
+		for s, c := range dist {
+			if !done[s] && (!found || c.less(dist[cur])) {
+				cur, found = s, true
+			}
+		}
+		if !found {
+			return turnsAndClicks{}, false
+		}
+		if goal(cur) {
+			return dist[cur], true
+		}
+		done[cur] = true
+		for _, dir := range []Direction{Left, Right} {
+			for clicks := 1; clicks <= (passes+2)*size; clicks++ {
+				d := New(size, cur.position, target)
+				d.Turn(dir, clicks)
+				c := d.Count(target)
+				next := state{d.Position(), cur.lands + c.Lands, cur.passes + c.Passes}
+				if next.lands > lands || next.passes > passes {
+					continue
+				}
+				nc := turnsAndClicks{dist[cur].turns + 1, dist[cur].clicks + clicks}
+				if old, ok := dist[next]; !ok || nc.less(old) {
+					dist[next] = nc
+				}
+			}
+		}
+	}
+}
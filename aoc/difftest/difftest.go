@@ -0,0 +1,122 @@
+/*
+Package difftest checks a fast solver against a brute-force oracle on random
+inputs. When the two disagree it shrinks the input, one simplification at a
+time, to a small case that still shows the difference:
+
+	func TestAgainstOracle(t *testing.T) {
+		difftest.Run(t, difftest.Test[string, int]{
+			Generate: randomBank,
+			Shrink:   difftest.ShrinkString,
+			Oracle:   bruteJoltage,
+			Fast:     maxJoltage,
+		})
+	}
+
+Every input is drawn from its own seed, which a failure reports so it can
+be replayed with -difftest.seed. -difftest.n sets how many inputs each test
+tries.
+*/
+package difftest
+
+import (
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+	"time"
+)
+
+var (
+	iterations = flag.Int("difftest.n", 300, "random inputs per differential test (30 with -short)")
+	seedFlag   = flag.Uint64("difftest.seed", 0, "replay the single input drawn from this seed")
+)
+
+// maxShrinks bounds the shrinking loop, so a Shrink function that fails to
+// make progress cannot hang a test.
+const maxShrinks = 10_000
+
+// Test pairs a brute-force Oracle with the Fast code it vouches for, over
+// inputs of type T with results of type R.
+type Test[T any, R comparable] struct {
+	// Generate draws one input, small enough for the oracle.
+	Generate func(rng *rand.Rand) T
+
+	// Shrink returns simpler variants of an input, most drastic first. It
+	// may be nil, in which case failures are reported unshrunk.
+	Shrink func(T) []T
+
+	// Valid, if set, rejects shrunk inputs that break the puzzle's rules,
+	// such as a bank with too few batteries.
+	Valid func(T) bool
+
+	Oracle, Fast func(T) R
+
+	// Format prints an input in a failure, preferably in the puzzle's own
+	// format so it can be pasted into a sample file. It defaults to %v.
+	Format func(T) string
+}
+
+// Run tries Fast against Oracle on -difftest.n random inputs and fails the
+// test with a shrunk counterexample at the first disagreement.
+func Run[T any, R comparable](t *testing.T, tt Test[T, R]) {
+	t.Helper()
+	n, base := *iterations, *seedFlag
+	switch {
+	case base != 0:
+		n = 1
+	case testing.Short():
+		n = min(n, 30)
+	}
+	if base == 0 {
+		base = uint64(time.Now().UnixNano())
+	}
+
+	for i := range uint64(n) {
+		seed := base + i
+		input := tt.Generate(rand.New(rand.NewPCG(seed, 0)))
+		if !tt.differs(input) {
+			continue
+		}
+		shrunk, steps := tt.shrink(input)
+		want, got := tt.Oracle(shrunk), tt.Fast(shrunk)
+		t.Fatalf("fast and oracle disagree (replay with -difftest.seed=%d, shrunk in %d steps)\ninput:\n%s\noracle: %v\nfast:   %v",
+			seed, steps, tt.format(shrunk), want, got)
+	}
+}
+
+func (tt Test[T, R]) differs(input T) bool {
+	return tt.Oracle(input) != tt.Fast(input)
+}
+
+// shrink greedily replaces input by its first simpler variant that still
+// makes the two disagree, until no variant does.
+func (tt Test[T, R]) shrink(input T) (T, int) {
+	if tt.Shrink == nil {
+		return input, 0
+	}
+	steps := 0
+	for steps < maxShrinks {
+		progressed := false
+		for _, candidate := range tt.Shrink(input) {
+			if tt.Valid != nil && !tt.Valid(candidate) {
+				continue
+			}
+			if tt.differs(candidate) {
+				input, progressed = candidate, true
+				steps++
+				break
+			}
+		}
+		if !progressed {
+			break
+		}
+	}
+	return input, steps
+}
+
+func (tt Test[T, R]) format(input T) string {
+	if tt.Format != nil {
+		return tt.Format(input)
+	}
+	return fmt.Sprintf("%v", input)
+}
@@ -0,0 +1,74 @@
+package difftest
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestShrinkSlice(t *testing.T) {
+	got := ShrinkSlice([]int{1, 2, 3, 4}, nil)
+	want := [][]int{{3, 4}, {1, 2}, {2, 3, 4}, {1, 3, 4}, {1, 2, 4}, {1, 2, 3}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ShrinkSlice = %v, want %v", got, want)
+	}
+
+	got = ShrinkSlice([]int{5}, ShrinkInt)
+	want = [][]int{nil, {0}, {2}, {4}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("ShrinkSlice with elements = %v, want %v", got, want)
+	}
+}
+
+func TestShrinkInt(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []int
+	}{
+		{0, nil},
+		{1, []int{0}},
+		{2, []int{0, 1}},
+		{7, []int{0, 3, 6}},
+		{-7, []int{0, -3, -6}},
+	}
+	for _, tt := range tests {
+		if got := ShrinkInt(tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("ShrinkInt(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+// A sum that forgets negative numbers should shrink to a single -1.
+func TestShrink(t *testing.T) {
+	tt := Test[[]int, int]{
+		Generate: func(rng *rand.Rand) []int { return nil },
+		Shrink:   func(s []int) [][]int { return ShrinkSlice(s, ShrinkInt) },
+		Oracle: func(s []int) int {
+			total := 0
+			for _, n := range s {
+				total += n
+			}
+			return total
+		},
+		Fast: func(s []int) int {
+			total := 0
+			for _, n := range s {
+				total += max(n, 0)
+			}
+			return total
+		},
+	}
+
+	got, steps := tt.shrink([]int{4, 9, -12, 3, -5, 8})
+	if !slices.Equal(got, []int{-1}) {
+		t.Errorf("shrink = %v after %d steps, want [-1]", got, steps)
+	}
+}
+
+func TestRunAgrees(t *testing.T) {
+	Run(t, Test[int, int]{
+		Generate: func(rng *rand.Rand) int { return rng.IntN(1000) },
+		Oracle:   func(n int) int { return n * 3 },
+		Fast:     func(n int) int { return n + n + n },
+	})
+}
@@ -0,0 +1,59 @@
+package difftest
+
+// ShrinkSlice returns simpler variants of s: with runs of elements removed,
+// from half of the slice down to single elements, and then with one element
+// replaced by each of the variants shrinkElem gives for it. shrinkElem may
+// be nil to only remove elements.
+func ShrinkSlice[E any](s []E, shrinkElem func(E) []E) [][]E {
+	var variants [][]E
+	for size := len(s) / 2; size > 0; size /= 2 {
+		for start := 0; start+size <= len(s); start += size {
+			variant := make([]E, 0, len(s)-size)
+			variant = append(variant, s[:start]...)
+			variants = append(variants, append(variant, s[start+size:]...))
+		}
+	}
+	if len(s) == 1 {
+		variants = append(variants, nil)
+	}
+	if shrinkElem == nil {
+		return variants
+	}
+	for i, e := range s {
+		for _, smaller := range shrinkElem(e) {
+			variant := append([]E(nil), s...)
+			variant[i] = smaller
+			variants = append(variants, variant)
+		}
+	}
+	return variants
+}
+
+// ShrinkString returns s with runs of bytes removed, as ShrinkSlice does.
+func ShrinkString(s string) []string {
+	var variants []string
+	for _, b := range ShrinkSlice([]byte(s), nil) {
+		variants = append(variants, string(b))
+	}
+	return variants
+}
+
+// ShrinkInt returns integers closer to zero than n: zero itself, half of
+// n, and n moved one step towards zero.
+func ShrinkInt[T ~int | ~int64](n T) []T {
+	if n == 0 {
+		return nil
+	}
+	step := n - 1
+	if n < 0 {
+		step = n + 1
+	}
+	variants := []T{0}
+	if half := n / 2; half != 0 {
+		variants = append(variants, half)
+	}
+	if step != 0 && step != n/2 {
+		variants = append(variants, step)
+	}
+	return variants
+}
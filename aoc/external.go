@@ -0,0 +1,127 @@
+package aoc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+)
+
+// External returns a constructor for solvers that run a program written in
+// another language, such as an older year's Python script, so it can be
+// run, verified and benchmarked like the Go days:
+//
+//	aoc.Register(2023, 1, aoc.External("python3", "-c", script), samples)
+//
+// The program gets the puzzle input on stdin and prints its answers as
+// "Part 1: X" and "Part 2: Y" lines. Indented lines after an answer become
+// that part's diagnostics and anything else is ignored. A part the program
+// prints no answer for is ErrUnsolved.
+//
+// The program runs once, when the first part is asked for, so its whole
+// running time is counted against that part. Its stderr goes to the debug
+// log.
+func External(name string, args ...string) func() Solver {
+	return func() Solver {
+		return &external{name: name, args: args}
+	}
+}
+
+type external struct {
+	name string
+	args []string
+
+	input   []byte
+	ran     bool
+	answers map[int]Answer
+	err     error
+}
+
+func (e *external) Parse(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	e.input = data
+	return err
+}
+
+func (e *external) Part1(ctx context.Context) (Answer, error) {
+	return e.part(ctx, 1)
+}
+
+func (e *external) Part2(ctx context.Context) (Answer, error) {
+	return e.part(ctx, 2)
+}
+
+func (e *external) part(ctx context.Context, n int) (Answer, error) {
+	if !e.ran {
+		e.answers, e.err = e.run(ctx)
+		e.ran = true
+	}
+	if e.err != nil {
+		return Answer{}, e.err
+	}
+	a, ok := e.answers[n]
+	if !ok {
+		return Answer{}, ErrUnsolved
+	}
+	return a, nil
+}
+
+// run starts the program with the input on stdin and collects its answers.
+func (e *external) run(ctx context.Context) (map[int]Answer, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.name, e.args...)
+	cmd.Stdin = bytes.NewReader(e.input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+
+	log := logging.From(ctx)
+	lines := strings.Split(strings.TrimRight(stderr.String(), "\n"), "\n")
+	for _, line := range lines {
+		if line != "" {
+			log.Debugf("%s", line)
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("%s: %w", e.name, context.Cause(ctx))
+	}
+	if err != nil {
+		if last := lines[len(lines)-1]; last != "" {
+			return nil, fmt.Errorf("%s: %w: %s", e.name, err, last)
+		}
+		return nil, fmt.Errorf("%s: %w", e.name, err)
+	}
+	return parseAnswers(&stdout), nil
+}
+
+var answerLine = regexp.MustCompile(`^Part ([12]): (.*)$`)
+
+// parseAnswers reads the "Part N: X" lines of an external program's output,
+// with the indented lines under each as its diagnostics.
+func parseAnswers(r io.Reader) map[int]Answer {
+	answers := make(map[int]Answer)
+	part := 0
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		if m := answerLine.FindStringSubmatch(line); m != nil {
+			part, _ = strconv.Atoi(m[1])
+			answers[part] = Answer{Value: strings.TrimSpace(m[2])}
+			continue
+		}
+		if part != 0 && line != "" && (line[0] == ' ' || line[0] == '\t') {
+			answers[part] = answers[part].Notef("%s", strings.TrimSpace(line))
+			continue
+		}
+		part = 0
+	}
+	return answers
+}
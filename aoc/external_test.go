@@ -0,0 +1,25 @@
+package aoc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseAnswers(t *testing.T) {
+	out := `List of Lists: [[1000, 2000]]
+Part 1: 24000
+  Index with the Highest Sum: 3
+Part 2: 45000  
+not a diagnostic
+    also not one
+`
+	got := parseAnswers(strings.NewReader(out))
+	want := map[int]Answer{
+		1: {Value: "24000", Diagnostics: []string{"Index with the Highest Sum: 3"}},
+		2: {Value: "45000"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseAnswers = %#v, want %#v", got, want)
+	}
+}
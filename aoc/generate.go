@@ -0,0 +1,38 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+)
+
+// Generator writes random puzzle inputs for a day, for stress-testing its
+// solver on inputs larger or stranger than the real one.
+type Generator struct {
+	// Generate writes one valid input of about size units to w, drawing
+	// every random choice from rng so a seed reproduces the input.
+	Generate func(w io.Writer, size int, rng *rand.Rand) error
+
+	// Size is the default size, close to that of a real input, and Unit
+	// says what it counts, such as "rotations" or "grid rows".
+	Size int
+	Unit string
+}
+
+var generators = make(map[key]Generator)
+
+// RegisterGenerator adds a day's input generator. Like Register it panics
+// if the day already has one.
+func RegisterGenerator(year, day int, g Generator) {
+	k := key{year, day}
+	if _, ok := generators[k]; ok {
+		panic(fmt.Sprintf("aoc: %d day %d generator registered twice", year, day))
+	}
+	generators[k] = g
+}
+
+// LookupGenerator returns the generator registered for year and day.
+func LookupGenerator(year, day int) (Generator, bool) {
+	g, ok := generators[key{year, day}]
+	return g, ok
+}
@@ -0,0 +1,77 @@
+/*
+Package geom has integer points in two and three dimensions and the
+arithmetic puzzles do on them.
+*/
+package geom
+
+// Number is any integer type puzzles count with.
+type Number interface {
+	~int | ~int64
+}
+
+// Abs returns the absolute value of n.
+func Abs[T Number](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of n.
+func Sign[T Number](n T) T {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+// Point is a position on a plane.
+type Point struct {
+	X, Y int
+}
+
+// Add returns p+q.
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub returns p-q.
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int {
+	return Abs(p.X-q.X) + Abs(p.Y-q.Y)
+}
+
+// TileArea returns the number of grid tiles in the axis-aligned rectangle
+// with opposite corners p and q, counting both edges.
+func TileArea(p, q Point) int {
+	return (Abs(p.X-q.X) + 1) * (Abs(p.Y-q.Y) + 1)
+}
+
+// Point3 is a position in space.
+type Point3 struct {
+	X, Y, Z int
+}
+
+// Sub returns p-q.
+func (p Point3) Sub(q Point3) Point3 {
+	return Point3{X: p.X - q.X, Y: p.Y - q.Y, Z: p.Z - q.Z}
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point3) Manhattan(q Point3) int {
+	return Abs(p.X-q.X) + Abs(p.Y-q.Y) + Abs(p.Z-q.Z)
+}
+
+// DistSq returns the squared straight-line distance between p and q. It
+// orders pairs exactly like the distance itself without leaving integers.
+func (p Point3) DistSq(q Point3) int {
+	d := p.Sub(q)
+	return d.X*d.X + d.Y*d.Y + d.Z*d.Z
+}
@@ -0,0 +1,36 @@
+package geom
+
+import "testing"
+
+func TestAbsSign(t *testing.T) {
+	for _, tt := range []struct{ n, abs, sign int }{
+		{-7, 7, -1}, {0, 0, 0}, {3, 3, 1},
+	} {
+		if got := Abs(tt.n); got != tt.abs {
+			t.Errorf("Abs(%d) = %d, want %d", tt.n, got, tt.abs)
+		}
+		if got := Sign(tt.n); got != tt.sign {
+			t.Errorf("Sign(%d) = %d, want %d", tt.n, got, tt.sign)
+		}
+	}
+}
+
+func TestTileArea(t *testing.T) {
+	// The day 9 example: corners 2,5 and 11,1 span 10x5 tiles.
+	if got := TileArea(Point{X: 2, Y: 5}, Point{X: 11, Y: 1}); got != 50 {
+		t.Errorf("TileArea = %d, want 50", got)
+	}
+	if got := TileArea(Point{X: 4, Y: 4}, Point{X: 4, Y: 4}); got != 1 {
+		t.Errorf("TileArea of a single tile = %d, want 1", got)
+	}
+}
+
+func TestPoint3(t *testing.T) {
+	p, q := Point3{X: 1, Y: 2, Z: 3}, Point3{X: 4, Y: -2, Z: 3}
+	if got := p.DistSq(q); got != 25 {
+		t.Errorf("DistSq = %d, want 25", got)
+	}
+	if got := p.Manhattan(q); got != 7 {
+		t.Errorf("Manhattan = %d, want 7", got)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Package graph has directed graphs keyed by node name or number, and the
+path counting and searches puzzles run on them.
+*/
+package graph
+
+// Digraph maps each node to the nodes its edges lead to.
+type Digraph[N comparable] map[N][]N
+
+// AddEdge adds an edge from -> to.
+func (g Digraph[N]) AddEdge(from, to N) {
+	g[from] = append(g[from], to)
+}
+
+// CountPaths counts the paths from start to end. If required is non-empty,
+// only paths visiting every required node are counted. Paths are memoised
+// per node, so the graph must be acyclic; at most 63 nodes can be required.
+func (g Digraph[N]) CountPaths(start, end N, required ...N) int {
+	reqIndex := make(map[N]int, len(required))
+	for i, r := range required {
+		reqIndex[r] = i
+	}
+	allVisited := uint64(1)<<len(required) - 1
+
+	type memoKey struct {
+		node    N
+		visited uint64
+	}
+	memo := make(map[memoKey]int)
+
+	var dfs func(node N, visited uint64) int
+	dfs = func(node N, visited uint64) int {
+		if idx, ok := reqIndex[node]; ok {
+			visited |= 1 << idx
+		}
+
+		if node == end {
+			if visited == allVisited {
+				return 1
+			}
+			return 0
+		}
+
+		key := memoKey{node, visited}
+		if val, ok := memo[key]; ok {
+			return val
+		}
+
+		total := 0
+		for _, next := range g[node] {
+			total += dfs(next, visited)
+		}
+
+		memo[key] = total
+		return total
+	}
+
+	return dfs(start, 0)
+}
+
+// Distances returns the number of edges on the shortest path from start to
+// every node reachable from it.
+func (g Digraph[N]) Distances(start N) map[N]int {
+	dist := map[N]int{start: 0}
+	queue := []N{start}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, next := range g[node] {
+			if _, seen := dist[next]; !seen {
+				dist[next] = dist[node] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return dist
+}
+
+// TopoSort returns the nodes reachable from the graph's sources ordered so
+// every edge points forward. It reports false if the graph has a cycle.
+func (g Digraph[N]) TopoSort() ([]N, bool) {
+	indegree := make(map[N]int)
+	var nodes []N
+	seen := make(map[N]bool)
+	add := func(n N) {
+		if !seen[n] {
+			seen[n] = true
+			nodes = append(nodes, n)
+		}
+	}
+	for from, tos := range g {
+		add(from)
+		for _, to := range tos {
+			add(to)
+			indegree[to]++
+		}
+	}
+
+	var queue []N
+	for _, n := range nodes {
+		if indegree[n] == 0 {
+			queue = append(queue, n)
+		}
+	}
+	order := make([]N, 0, len(nodes))
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		order = append(order, n)
+		for _, next := range g[n] {
+			if indegree[next]--; indegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+	return order, len(order) == len(nodes)
+}
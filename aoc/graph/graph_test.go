@@ -0,0 +1,65 @@
+package graph
+
+import "testing"
+
+// diamond has two routes from a to d, through b or c.
+func diamond() Digraph[string] {
+	g := make(Digraph[string])
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	return g
+}
+
+func TestCountPaths(t *testing.T) {
+	g := diamond()
+	tests := []struct {
+		start, end string
+		required   []string
+		want       int
+	}{
+		{"a", "e", nil, 2},
+		{"a", "e", []string{"b"}, 1},
+		{"a", "e", []string{"b", "c"}, 0},
+		{"b", "a", nil, 0},
+		{"d", "d", nil, 1},
+	}
+	for _, tt := range tests {
+		if got := g.CountPaths(tt.start, tt.end, tt.required...); got != tt.want {
+			t.Errorf("CountPaths(%s, %s, %v) = %d, want %d", tt.start, tt.end, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestDistances(t *testing.T) {
+	dist := diamond().Distances("a")
+	if dist["d"] != 2 || dist["e"] != 3 || len(dist) != 5 {
+		t.Errorf("Distances = %v", dist)
+	}
+}
+
+func TestTopoSort(t *testing.T) {
+	g := diamond()
+	order, ok := g.TopoSort()
+	if !ok || len(order) != 5 {
+		t.Fatalf("TopoSort = %v, %v", order, ok)
+	}
+	pos := make(map[string]int)
+	for i, n := range order {
+		pos[n] = i
+	}
+	for from, tos := range g {
+		for _, to := range tos {
+			if pos[from] > pos[to] {
+				t.Errorf("edge %s -> %s points backwards in %v", from, to, order)
+			}
+		}
+	}
+
+	g.AddEdge("e", "a")
+	if _, ok := g.TopoSort(); ok {
+		t.Error("TopoSort accepted a cycle")
+	}
+}
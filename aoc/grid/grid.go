@@ -0,0 +1,208 @@
+/*
+Package grid holds character maps such as "#.@" puzzle layouts.
+
+Positions are geom.Points with X as the column and Y as the row, so Y grows
+downwards as the input is read.
+*/
+package grid
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// Directions for stepping to a neighbouring cell.
+var (
+	Up    = geom.Point{X: 0, Y: -1}
+	Down  = geom.Point{X: 0, Y: 1}
+	Left  = geom.Point{X: -1, Y: 0}
+	Right = geom.Point{X: 1, Y: 0}
+
+	// Dirs4 are the orthogonal neighbours, clockwise from up.
+	Dirs4 = []geom.Point{Up, Right, Down, Left}
+
+	// Dirs8 adds the diagonals, clockwise from up.
+	Dirs8 = []geom.Point{
+		Up, {X: 1, Y: -1}, Right, {X: 1, Y: 1},
+		Down, {X: -1, Y: 1}, Left, {X: -1, Y: -1},
+	}
+)
+
+// Grid is a rectangular map of bytes indexed [row][column].
+type Grid [][]byte
+
+// Read reads a grid with one row per non-empty line. Short rows are padded
+// with '.' so every row is as wide as the widest.
+func Read(r io.Reader) (Grid, error) {
+	lines, err := parse.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	var rows []string
+	for _, line := range lines {
+		if line != "" {
+			rows = append(rows, line)
+		}
+	}
+	return FromLines(rows), nil
+}
+
+// ReadValid reads a grid like Read, but checks it as it goes: every byte
+// must be in alphabet and every row as wide as the first. Problems are
+// reported through parse.Scanner, so r may be a lenient parse.Source, in
+// which case bad bytes are kept and ragged rows padded.
+func ReadValid(r io.Reader, alphabet string) (Grid, error) {
+	expected := "one of " + fmt.Sprintf("%q", alphabet)
+	sc := parse.NewScanner(r)
+	var rows []string
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" {
+			continue
+		}
+		if len(rows) > 0 && len(line) != len(rows[0]) {
+			col := min(len(line), len(rows[0])) + 1
+			got := ""
+			if len(line) > len(rows[0]) {
+				got = line[len(rows[0]):]
+			}
+			if err := sc.Bad(col, fmt.Sprintf("a row %d wide", len(rows[0])), got); err != nil {
+				return nil, err
+			}
+		}
+		for i := 0; i < len(line); i++ {
+			if strings.IndexByte(alphabet, line[i]) == -1 {
+				if err := sc.Bad(i+1, expected, line[i:i+1]); err != nil {
+					return nil, err
+				}
+			}
+		}
+		rows = append(rows, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		if err := sc.Missing("grid rows"); err != nil {
+			return nil, err
+		}
+	}
+	return FromLines(rows), nil
+}
+
+// FromLines copies lines into a Grid, padding short rows with '.'.
+func FromLines(lines []string) Grid {
+	width := 0
+	for _, line := range lines {
+		width = max(width, len(line))
+	}
+	g := make(Grid, len(lines))
+	for i, line := range lines {
+		g[i] = make([]byte, width)
+		n := copy(g[i], line)
+		for j := n; j < width; j++ {
+			g[i][j] = '.'
+		}
+	}
+	return g
+}
+
+// New returns a rows×cols grid filled with fill.
+func New(rows, cols int, fill byte) Grid {
+	g := make(Grid, rows)
+	for i := range g {
+		g[i] = make([]byte, cols)
+		for j := range g[i] {
+			g[i][j] = fill
+		}
+	}
+	return g
+}
+
+// Rows returns the number of rows.
+func (g Grid) Rows() int { return len(g) }
+
+// Cols returns the number of columns.
+func (g Grid) Cols() int {
+	if len(g) == 0 {
+		return 0
+	}
+	return len(g[0])
+}
+
+// In reports whether p lies on the grid.
+func (g Grid) In(p geom.Point) bool {
+	return p.Y >= 0 && p.Y < len(g) && p.X >= 0 && p.X < len(g[p.Y])
+}
+
+// At returns the byte at p, or 0 if p is off the grid.
+func (g Grid) At(p geom.Point) byte {
+	if !g.In(p) {
+		return 0
+	}
+	return g[p.Y][p.X]
+}
+
+// Set stores c at p, which must be on the grid.
+func (g Grid) Set(p geom.Point, c byte) {
+	g[p.Y][p.X] = c
+}
+
+// Find returns the first position holding c, scanning row by row.
+func (g Grid) Find(c byte) (geom.Point, bool) {
+	for y, row := range g {
+		for x, b := range row {
+			if b == c {
+				return geom.Point{X: x, Y: y}, true
+			}
+		}
+	}
+	return geom.Point{}, false
+}
+
+// All returns every position holding c, row by row.
+func (g Grid) All(c byte) []geom.Point {
+	var found []geom.Point
+	for y, row := range g {
+		for x, b := range row {
+			if b == c {
+				found = append(found, geom.Point{X: x, Y: y})
+			}
+		}
+	}
+	return found
+}
+
+// Count returns the number of neighbours of p, taken from dirs, that hold c.
+func (g Grid) Count(p geom.Point, dirs []geom.Point, c byte) int {
+	n := 0
+	for _, d := range dirs {
+		if g.At(p.Add(d)) == c {
+			n++
+		}
+	}
+	return n
+}
+
+// Clone returns a deep copy of g.
+func (g Grid) Clone() Grid {
+	c := make(Grid, len(g))
+	for i, row := range g {
+		c[i] = append([]byte(nil), row...)
+	}
+	return c
+}
+
+// String renders the grid one row per line.
+func (g Grid) String() string {
+	var b []byte
+	for _, row := range g {
+		b = append(b, row...)
+		b = append(b, '\n')
+	}
+	return string(b)
+}
@@ -0,0 +1,72 @@
+package grid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc/geom"
+)
+
+func TestRead(t *testing.T) {
+	g, err := Read(strings.NewReader("..@\n@@\n\n.S.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Rows() != 3 || g.Cols() != 3 {
+		t.Fatalf("grid is %dx%d, want 3x3", g.Rows(), g.Cols())
+	}
+	if got, want := g.String(), "..@\n@@.\n.S.\n"; got != want {
+		t.Errorf("grid = %q, want %q", got, want)
+	}
+	if p, ok := g.Find('S'); !ok || p != (geom.Point{X: 1, Y: 2}) {
+		t.Errorf("Find('S') = %v, %v", p, ok)
+	}
+	if got := len(g.All('@')); got != 3 {
+		t.Errorf("All('@') found %d, want 3", got)
+	}
+}
+
+func TestReadValid(t *testing.T) {
+	if _, err := ReadValid(strings.NewReader("..@\n@@.\n"), ".@"); err != nil {
+		t.Errorf("valid grid: %v", err)
+	}
+	tests := []struct{ in, want string }{
+		{"..@\n@x.\n", `line 2:2: expected one of ".@", got "x"`},
+		{"..@\n@@\n", `line 2:3: expected a row 3 wide, got end of line`},
+		{"..@\n@@..\n", `line 2:4: expected a row 3 wide, got "."`},
+		{"\n", `line 2: expected grid rows, got end of input`},
+	}
+	for _, tt := range tests {
+		_, err := ReadValid(strings.NewReader(tt.in), ".@")
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("ReadValid(%q) = %v, want %s", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	g := FromLines([]string{"@@@", "@.@", "@@@"})
+	centre := geom.Point{X: 1, Y: 1}
+	if got := g.Count(centre, Dirs8, '@'); got != 8 {
+		t.Errorf("Count 8 = %d, want 8", got)
+	}
+	if got := g.Count(centre, Dirs4, '@'); got != 4 {
+		t.Errorf("Count 4 = %d, want 4", got)
+	}
+	// Off-grid neighbours never match.
+	if got := g.Count(geom.Point{}, Dirs8, '@'); got != 2 {
+		t.Errorf("corner Count = %d, want 2", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	g := New(2, 2, '.')
+	c := g.Clone()
+	c.Set(geom.Point{X: 1, Y: 1}, '#')
+	if g.At(geom.Point{X: 1, Y: 1}) != '.' {
+		t.Error("Clone shares rows with the original")
+	}
+	if g.At(geom.Point{X: 5, Y: 0}) != 0 {
+		t.Error("At off the grid is not 0")
+	}
+}
@@ -0,0 +1,87 @@
+package aoc
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Stdin is the input spec that reads the puzzle from standard input.
+const Stdin = "-"
+
+// samplePrefix marks an input spec naming an embedded sample fixture.
+const samplePrefix = "sample:"
+
+// OpenInput opens the puzzle input for s described by spec:
+//
+//	""             the day's checked-in input, e.g. 2025-12-01/input.txt
+//	"-"            standard input
+//	"sample:NAME"  the sample fixture samples/NAME.txt embedded in the day
+//	anything else  a file path, with {year} and {day} expanded so one spec
+//	               such as "inputs/alice/{year}-{day}.txt" covers a year
+func OpenInput(s Solution, spec string) (io.ReadCloser, error) {
+	switch {
+	case spec == "":
+		return os.Open(filepath.Join(Dir(s.Year, s.Day), "input.txt"))
+	case spec == Stdin:
+		return io.NopCloser(os.Stdin), nil
+	case strings.HasPrefix(spec, samplePrefix):
+		return openSample(s, strings.TrimPrefix(spec, samplePrefix))
+	default:
+		return os.Open(ExpandPath(spec, s.Year, s.Day))
+	}
+}
+
+// InputName names the input OpenInput would open for spec, for use in
+// messages: the file path, a sample's path inside the day, or "stdin".
+func InputName(s Solution, spec string) string {
+	switch {
+	case spec == "":
+		return filepath.Join(Dir(s.Year, s.Day), "input.txt")
+	case spec == Stdin:
+		return "stdin"
+	case strings.HasPrefix(spec, samplePrefix):
+		return filepath.Join(Dir(s.Year, s.Day), "samples", strings.TrimPrefix(spec, samplePrefix)+".txt")
+	default:
+		return ExpandPath(spec, s.Year, s.Day)
+	}
+}
+
+// ExpandPath replaces {year} and {day} in p; the day is zero-padded to
+// match the folder names.
+func ExpandPath(p string, year, day int) string {
+	return strings.NewReplacer(
+		"{year}", fmt.Sprintf("%04d", year),
+		"{day}", fmt.Sprintf("%02d", day),
+	).Replace(p)
+}
+
+func openSample(s Solution, name string) (io.ReadCloser, error) {
+	if s.Samples == nil {
+		return nil, fmt.Errorf("%d day %d has no samples", s.Year, s.Day)
+	}
+	f, err := s.Samples.Open(path.Join("samples", name+".txt"))
+	if err != nil {
+		return nil, fmt.Errorf("%d day %d has no sample %q (have %v)", s.Year, s.Day, name, SampleNames(s))
+	}
+	return f, nil
+}
+
+// SampleNames lists the sample fixtures embedded in a day, sorted by name.
+func SampleNames(s Solution) []string {
+	if s.Samples == nil {
+		return nil
+	}
+	matches, _ := fs.Glob(s.Samples, "samples/*.txt")
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = strings.TrimSuffix(path.Base(m), ".txt")
+	}
+	sort.Strings(names)
+	return names
+}
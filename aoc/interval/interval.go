@@ -0,0 +1,74 @@
+/*
+Package interval works with closed integer ranges such as "3-5", which
+holds 3, 4 and 5.
+*/
+package interval
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Number is any integer type an interval can span.
+type Number interface {
+	~int | ~int64
+}
+
+// Interval is the closed range [Lo, Hi].
+type Interval[T Number] struct {
+	Lo, Hi T
+}
+
+// Contains reports whether n lies in the interval.
+func (iv Interval[T]) Contains(n T) bool {
+	return iv.Lo <= n && n <= iv.Hi
+}
+
+// Len returns the number of integers in the interval, 0 if Hi < Lo.
+func (iv Interval[T]) Len() T {
+	if iv.Hi < iv.Lo {
+		return 0
+	}
+	return iv.Hi - iv.Lo + 1
+}
+
+// Merge returns the union of ivs as sorted, disjoint intervals. Intervals
+// that overlap or touch, like 3-5 and 6-8, are joined. ivs is not modified.
+func Merge[T Number](ivs []Interval[T]) []Interval[T] {
+	sorted := slices.Clone(ivs)
+	slices.SortFunc(sorted, func(a, b Interval[T]) int {
+		return cmp.Compare(a.Lo, b.Lo)
+	})
+
+	var merged []Interval[T]
+	for _, current := range sorted {
+		if current.Hi < current.Lo {
+			continue
+		}
+		if n := len(merged); n > 0 && current.Lo <= merged[n-1].Hi+1 {
+			merged[n-1].Hi = max(merged[n-1].Hi, current.Hi)
+			continue
+		}
+		merged = append(merged, current)
+	}
+	return merged
+}
+
+// Total returns the number of integers covered by ivs, counting overlaps
+// once.
+func Total[T Number](ivs []Interval[T]) T {
+	var total T
+	for _, iv := range Merge(ivs) {
+		total += iv.Len()
+	}
+	return total
+}
+
+// Find reports whether n lies in merged, which must come from Merge. It
+// binary searches, so lookups stay fast with many intervals.
+func Find[T Number](merged []Interval[T], n T) bool {
+	i, _ := slices.BinarySearchFunc(merged, n, func(iv Interval[T], n T) int {
+		return cmp.Compare(iv.Hi, n)
+	})
+	return i < len(merged) && merged[i].Contains(n)
+}
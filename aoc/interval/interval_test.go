@@ -0,0 +1,33 @@
+package interval
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	ivs := []Interval[int64]{{16, 20}, {3, 5}, {12, 18}, {10, 14}, {6, 6}, {30, 29}}
+	want := []Interval[int64]{{3, 6}, {10, 20}}
+	if got := Merge(ivs); !slices.Equal(got, want) {
+		t.Errorf("Merge = %v, want %v", got, want)
+	}
+	if ivs[0] != (Interval[int64]{16, 20}) {
+		t.Error("Merge reordered its argument")
+	}
+	if got := Total(ivs); got != 15 {
+		t.Errorf("Total = %d, want 15", got)
+	}
+	if got := Merge[int](nil); len(got) != 0 {
+		t.Errorf("Merge(nil) = %v", got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	merged := Merge([]Interval[int]{{3, 5}, {10, 14}, {16, 20}, {12, 18}})
+	for n := 0; n <= 22; n++ {
+		want := (3 <= n && n <= 5) || (10 <= n && n <= 20)
+		if got := Find(merged, n); got != want {
+			t.Errorf("Find(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
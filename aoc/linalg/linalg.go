@@ -0,0 +1,128 @@
+/*
+Package linalg solves small linear systems over the integers and over
+GF(2), the field of bits where 1+1 = 0.
+
+Matrices are [][]int indexed [row][column]. The elimination routines work
+on an augmented matrix, whose last column holds the right-hand side, and
+reduce it in place.
+*/
+package linalg
+
+// Augment returns a copy of m with b appended as an extra column.
+func Augment(m [][]int, b []int) [][]int {
+	aug := make([][]int, len(m))
+	for i, row := range m {
+		aug[i] = make([]int, len(row)+1)
+		copy(aug[i], row)
+		aug[i][len(row)] = b[i]
+	}
+	return aug
+}
+
+// RREF row-reduces the first nCols columns of aug over the integers and
+// returns the pivot column of each leading row. It never divides: a row is
+// cleared by scaling it with the pivot, so every value stays an integer but
+// pivots need not be 1.
+func RREF(aug [][]int, nCols int) []int {
+	var pivots []int
+	row := 0
+
+	for col := 0; col < nCols && row < len(aug); col++ {
+		pivotRow := findPivot(aug, row, col)
+		if pivotRow == -1 {
+			continue
+		}
+
+		aug[row], aug[pivotRow] = aug[pivotRow], aug[row]
+		pivots = append(pivots, col)
+
+		for r := range aug {
+			if r != row && aug[r][col] != 0 {
+				f1, f2 := aug[r][col], aug[row][col]
+				for c := 0; c <= nCols; c++ {
+					aug[r][c] = aug[r][c]*f2 - aug[row][c]*f1
+				}
+			}
+		}
+		row++
+	}
+	return pivots
+}
+
+// RREFGF2 row-reduces aug, whose entries are 0 or 1, over GF(2) and returns
+// the pivot column of each leading row. Every column but the last is
+// eliminated.
+func RREFGF2(aug [][]int) []int {
+	if len(aug) == 0 {
+		return nil
+	}
+	nCols := len(aug[0]) - 1
+	var pivots []int
+	row := 0
+
+	for col := 0; col < nCols && row < len(aug); col++ {
+		pivotRow := findPivot(aug, row, col)
+		if pivotRow == -1 {
+			continue
+		}
+
+		aug[row], aug[pivotRow] = aug[pivotRow], aug[row]
+		pivots = append(pivots, col)
+
+		for r := range aug {
+			if r != row && aug[r][col] == 1 {
+				for c := range aug[r] {
+					aug[r][c] ^= aug[row][c]
+				}
+			}
+		}
+		row++
+	}
+	return pivots
+}
+
+// Consistent reports whether a system reduced by RREF or RREFGF2 has a
+// solution: no row without a pivot may ask for a non-zero right-hand side.
+func Consistent(aug [][]int, pivots []int) bool {
+	for row := len(pivots); row < len(aug); row++ {
+		if aug[row][len(aug[row])-1] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// FreeColumns returns the columns below nCols that hold no pivot; their
+// variables can take any value.
+func FreeColumns(pivots []int, nCols int) []int {
+	isPivot := make([]bool, nCols)
+	for _, p := range pivots {
+		isPivot[p] = true
+	}
+
+	var free []int
+	for i := 0; i < nCols; i++ {
+		if !isPivot[i] {
+			free = append(free, i)
+		}
+	}
+	return free
+}
+
+// Sum returns the sum of v's entries.
+func Sum(v []int) int {
+	total := 0
+	for _, x := range v {
+		total += x
+	}
+	return total
+}
+
+func findPivot(aug [][]int, startRow, col int) int {
+	for row := startRow; row < len(aug); row++ {
+		if aug[row][col] != 0 {
+			return row
+		}
+	}
+	return -1
+}
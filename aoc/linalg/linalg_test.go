@@ -0,0 +1,52 @@
+package linalg
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRREF(t *testing.T) {
+	// x + y = 3, x - y = 1, so x = 2 and y = 1.
+	aug := Augment([][]int{{1, 1}, {1, -1}}, []int{3, 1})
+	pivots := RREF(aug, 2)
+	if !slices.Equal(pivots, []int{0, 1}) {
+		t.Fatalf("pivots = %v", pivots)
+	}
+	for i, want := range []int{2, 1} {
+		row := aug[i]
+		if row[2]%row[i] != 0 || row[2]/row[i] != want {
+			t.Errorf("row %d = %v, want x%d = %d", i, row, i, want)
+		}
+	}
+	if !Consistent(aug, pivots) {
+		t.Error("solvable system reported inconsistent")
+	}
+}
+
+func TestRREFFreeColumns(t *testing.T) {
+	aug := Augment([][]int{{1, 2, 1}, {2, 4, 2}}, []int{4, 8})
+	pivots := RREF(aug, 3)
+	if !slices.Equal(pivots, []int{0}) {
+		t.Fatalf("pivots = %v, want [0]", pivots)
+	}
+	if free := FreeColumns(pivots, 3); !slices.Equal(free, []int{1, 2}) {
+		t.Errorf("FreeColumns = %v, want [1 2]", free)
+	}
+}
+
+func TestRREFGF2(t *testing.T) {
+	// a^b = 1, b^c = 1, a^c = 1 has no solution: the rows sum to 0 = 1.
+	aug := Augment([][]int{{1, 1, 0}, {0, 1, 1}, {1, 0, 1}}, []int{1, 1, 1})
+	pivots := RREFGF2(aug)
+	if len(pivots) != 2 {
+		t.Fatalf("pivots = %v, want rank 2", pivots)
+	}
+	if Consistent(aug, pivots) {
+		t.Error("inconsistent system reported solvable")
+	}
+
+	aug = Augment([][]int{{1, 1, 0}, {0, 1, 1}, {1, 0, 1}}, []int{1, 1, 0})
+	if !Consistent(aug, RREFGF2(aug)) {
+		t.Error("solvable system reported inconsistent")
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Package logging carries a leveled logger to solvers through their context,
+so they can explain what they are doing without printing next to the
+answers:
+
+	log := logging.From(ctx)
+	log.Debugf("%d circuits remain", len(sizes))
+	if log.Enabled(logging.Trace) {
+		// work done only to be logged
+	}
+
+The runner attaches a Logger writing to stderr at the level chosen with
+-v. Without one, From returns nil, whose methods do nothing.
+*/
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Level is how much a logger lets through; each level includes the ones
+// before it.
+type Level int
+
+const (
+	Quiet Level = iota // nothing but answers and errors
+	Info               // what a solver found on the way to an answer
+	Debug              // a line per item, such as each machine
+	Trace              // everything, including slow self-checks
+)
+
+var levelNames = []string{"quiet", "info", "debug", "trace"}
+
+func (l Level) String() string {
+	if l < Quiet || l > Trace {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the level with the given name.
+func ParseLevel(s string) (Level, error) {
+	for i, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(i), nil
+		}
+	}
+	return Quiet, fmt.Errorf("unknown log level %q (want %s)", s, strings.Join(levelNames, ", "))
+}
+
+// Logger writes lines at or below its level, each starting with its
+// prefix. Loggers made by With share their writer and its lock, so lines
+// from concurrent workers never interleave.
+type Logger struct {
+	mu     *sync.Mutex
+	w      io.Writer
+	level  Level
+	prefix string
+}
+
+// New returns a logger writing to w.
+func New(w io.Writer, level Level) *Logger {
+	return &Logger{mu: new(sync.Mutex), w: w, level: level}
+}
+
+// With returns a logger whose lines start with prefix, such as
+// "2025 day 9 part 2: ".
+func (l *Logger) With(prefix string) *Logger {
+	if l == nil {
+		return nil
+	}
+	c := *l
+	c.prefix = l.prefix + prefix
+	return &c
+}
+
+// Enabled reports whether lines at level are written, so a solver can skip
+// work done only to be logged.
+func (l *Logger) Enabled(level Level) bool {
+	return l != nil && level != Quiet && level <= l.level
+}
+
+func (l *Logger) Infof(format string, args ...any)  { l.logf(Info, format, args...) }
+func (l *Logger) Debugf(format string, args ...any) { l.logf(Debug, format, args...) }
+func (l *Logger) Tracef(format string, args ...any) { l.logf(Trace, format, args...) }
+
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if !l.Enabled(level) {
+		return
+	}
+	line := l.prefix + fmt.Sprintf(format, args...)
+	if !strings.HasSuffix(line, "\n") {
+		line += "\n"
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	io.WriteString(l.w, line)
+}
+
+type loggerKey struct{}
+
+// WithLogger returns a context carrying l.
+func WithLogger(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// From returns the context's logger, or nil, which logs nothing.
+func From(ctx context.Context) *Logger {
+	l, _ := ctx.Value(loggerKey{}).(*Logger)
+	return l
+}
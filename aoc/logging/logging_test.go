@@ -0,0 +1,59 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestLevels(t *testing.T) {
+	var out strings.Builder
+	log := New(&out, Debug).With("2025 day 9: ")
+	log.Infof("best %d", 42)
+	log.Debugf("checked %d rectangles\n", 7)
+	log.Tracef("corner (%d,%d)", 1, 2)
+
+	want := "2025 day 9: best 42\n2025 day 9: checked 7 rectangles\n"
+	if got := out.String(); got != want {
+		t.Errorf("logged %q, want %q", got, want)
+	}
+	if log.Enabled(Trace) || !log.Enabled(Debug) || log.Enabled(Quiet) {
+		t.Error("Enabled disagrees with the Debug level")
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	var out strings.Builder
+	log := New(&out, Quiet)
+	log.Infof("hidden")
+	if out.Len() != 0 {
+		t.Errorf("quiet logger wrote %q", out.String())
+	}
+}
+
+func TestFrom(t *testing.T) {
+	log := From(context.Background())
+	if log != nil {
+		t.Fatal("From without a logger returned one")
+	}
+	log.Infof("dropped") // a nil logger must not panic
+	if log.With("x").Enabled(Info) {
+		t.Error("nil logger is enabled")
+	}
+
+	l := New(&strings.Builder{}, Info)
+	if From(WithLogger(context.Background(), l)) != l {
+		t.Error("From did not return the attached logger")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, level := range []Level{Quiet, Info, Debug, Trace} {
+		if got, err := ParseLevel(level.String()); err != nil || got != level {
+			t.Errorf("ParseLevel(%q) = %v, %v", level, got, err)
+		}
+	}
+	if _, err := ParseLevel("loud"); err == nil {
+		t.Error("ParseLevel accepted loud")
+	}
+}
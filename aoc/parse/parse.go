@@ -0,0 +1,100 @@
+/*
+Package parse reads the line- and number-oriented text that puzzle inputs
+are made of.
+*/
+package parse
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Lines returns every line of r without its line ending.
+func Lines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	return lines, scanner.Err()
+}
+
+// NonEmptyLines returns the lines of r that hold more than whitespace,
+// trimmed.
+func NonEmptyLines(r io.Reader) ([]string, error) {
+	lines, err := Lines(r)
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return kept, err
+}
+
+// Blocks splits r into groups of lines separated by blank lines.
+func Blocks(r io.Reader) ([][]string, error) {
+	lines, err := Lines(r)
+	var blocks [][]string
+	var block []string
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			if block != nil {
+				blocks = append(blocks, block)
+				block = nil
+			}
+			continue
+		}
+		block = append(block, line)
+	}
+	if block != nil {
+		blocks = append(blocks, block)
+	}
+	return blocks, err
+}
+
+// Ints returns every integer in s, in order. A '-' directly before a digit
+// is a sign, so "3-5" reads as 3 and -5 but "x - 5" reads as 5.
+func Ints(s string) []int {
+	var nums []int
+	for i := 0; i < len(s); {
+		start := i
+		if s[i] == '-' && i+1 < len(s) && isDigit(s[i+1]) {
+			i++
+		}
+		if !isDigit(s[i]) {
+			i = start + 1
+			continue
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		n, err := strconv.Atoi(s[start:i])
+		if err == nil {
+			nums = append(nums, n)
+		}
+	}
+	return nums
+}
+
+// IntList parses s as integers separated by sep, such as "1,2,3". Space
+// around each number is ignored.
+func IntList(s, sep string) ([]int, error) {
+	parts := strings.Split(s, sep)
+	nums := make([]int, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		nums[i] = n
+	}
+	return nums, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
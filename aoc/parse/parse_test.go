@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []int
+	}{
+		{"162,817,812", []int{162, 817, 812}},
+		{"3-5", []int{3, -5}},
+		{"x - 5", []int{5}},
+		{"[.##.] (3) (1,3) {3,5,4,7}", []int{3, 1, 3, 3, 5, 4, 7}},
+		{"no numbers", nil},
+		{"-", nil},
+	}
+	for _, tt := range tests {
+		if got := Ints(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Ints(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIntList(t *testing.T) {
+	got, err := IntList("1, 2,3", ",")
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("IntList = %v, %v", got, err)
+	}
+	if _, err := IntList("1,x", ","); err == nil {
+		t.Error("IntList accepted a non-number")
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	got, err := Blocks(strings.NewReader("\na\nb\n\n\nc\n"))
+	want := [][]string{{"a", "b"}, {"c"}}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestNonEmptyLines(t *testing.T) {
+	got, err := NonEmptyLines(strings.NewReader(" a \r\n\n  \nb"))
+	if err != nil || !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("NonEmptyLines = %q, %v", got, err)
+	}
+}
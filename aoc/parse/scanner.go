@@ -0,0 +1,262 @@
+package parse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Error describes malformed input: where it is and what was expected there.
+// Line and Col are 1-based; Col is 0 when the problem is a missing line,
+// such as input that ends early.
+type Error struct {
+	File      string
+	Line, Col int
+	Expected  string
+	Got       string
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		fmt.Fprintf(&b, "%s:%d", e.File, e.Line)
+	} else {
+		fmt.Fprintf(&b, "line %d", e.Line)
+	}
+	if e.Col > 0 {
+		fmt.Fprintf(&b, ":%d", e.Col)
+	}
+	fmt.Fprintf(&b, ": expected %s, got %s", e.Expected, e.describeGot())
+	return b.String()
+}
+
+func (e *Error) describeGot() string {
+	switch {
+	case e.Got == "" && e.Col == 0:
+		return "end of input"
+	case e.Got == "":
+		return "end of line"
+	case len(e.Got) > 20:
+		return fmt.Sprintf("%q...", e.Got[:20])
+	}
+	return fmt.Sprintf("%q", e.Got)
+}
+
+// Source is a named puzzle input. Passing a Source to a solver's Parse, in
+// place of a bare reader, puts a name on any Error it reports and can make
+// parsing lenient: problems are then collected as warnings and the bad
+// line or token is skipped, instead of the first one failing the parse.
+// Its warnings may be read while another goroutine is still parsing it.
+type Source struct {
+	Name    string
+	Lenient bool
+
+	// MaxWarnings caps how many warnings are kept, for inputs that may
+	// never end; the ones past it are only counted. 0 keeps them all.
+	MaxWarnings int
+
+	r        io.Reader
+	mu       sync.Mutex
+	warnings []*Error
+	dropped  int
+}
+
+// NewSource returns a strict Source named name that reads from r.
+func NewSource(name string, r io.Reader) *Source {
+	return &Source{Name: name, r: r}
+}
+
+func (s *Source) Read(p []byte) (int, error) {
+	return s.r.Read(p)
+}
+
+// Warnings returns the problems skipped by a lenient parse, in input order.
+func (s *Source) Warnings() []*Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.warnings)
+}
+
+// Dropped returns how many warnings were counted but not kept, once
+// MaxWarnings were.
+func (s *Source) Dropped() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.dropped
+}
+
+func (s *Source) warn(e *Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.MaxWarnings > 0 && len(s.warnings) >= s.MaxWarnings {
+		s.dropped++
+		return
+	}
+	s.warnings = append(s.warnings, e)
+}
+
+// Scanner reads input line by line, numbering lines so that problems can
+// be reported with Bad. Over a Source it follows the Source's name and
+// mode; over any other reader it is strict.
+type Scanner struct {
+	src     *Source
+	scanner *bufio.Scanner
+	line    int
+	text    string
+}
+
+// NewScanner returns a Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	src, ok := r.(*Source)
+	if !ok {
+		src = NewSource("", r)
+	}
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(nil, 1<<20)
+	return &Scanner{src: src, scanner: scanner}
+}
+
+// Scan advances to the next line, reporting false at the end of the input.
+func (s *Scanner) Scan() bool {
+	if !s.scanner.Scan() {
+		return false
+	}
+	s.line++
+	s.text = strings.TrimSuffix(s.scanner.Text(), "\r")
+	return true
+}
+
+// Text returns the current line without its line ending.
+func (s *Scanner) Text() string { return s.text }
+
+// Line returns the number of the current line.
+func (s *Scanner) Line() int { return s.line }
+
+// Err returns the first read error, if any.
+func (s *Scanner) Err() error { return s.scanner.Err() }
+
+// Bad reports that the current line holds got at column col where expected
+// should be. In strict mode it returns the Error, which the caller should
+// return; in lenient mode it records a warning and returns nil, and the
+// caller skips what it could not read.
+func (s *Scanner) Bad(col int, expected, got string) error {
+	return s.BadAt(s.line, col, expected, got)
+}
+
+// BadAt is Bad for an earlier line, for problems only visible once later
+// lines have been read.
+func (s *Scanner) BadAt(line, col int, expected, got string) error {
+	return s.report(&Error{File: s.src.Name, Line: line, Col: col, Expected: expected, Got: got})
+}
+
+// BadLine reports that the current line as a whole is not expected.
+func (s *Scanner) BadLine(expected string) error {
+	return s.Bad(1, expected, s.text)
+}
+
+// Missing reports that the input ended while expected was still due.
+func (s *Scanner) Missing(expected string) error {
+	return s.report(&Error{File: s.src.Name, Line: s.line + 1, Expected: expected})
+}
+
+func (s *Scanner) report(e *Error) error {
+	if s.src.Lenient {
+		s.src.warn(e)
+		return nil
+	}
+	return e
+}
+
+// Ints reads the current line as exactly n integers separated by sep,
+// such as "162,817,812". It returns nil, nil when a lenient parse skips
+// the line.
+func (s *Scanner) Ints(n int, sep, expected string) ([]int, error) {
+	fields := Split(s.text, sep)
+	if len(fields) != n {
+		return nil, s.BadLine(expected)
+	}
+	nums := make([]int, n)
+	for i, f := range fields {
+		v, err := strconv.Atoi(f.Text)
+		if err != nil {
+			return nil, s.Bad(f.Col, "integer", f.Text)
+		}
+		nums[i] = v
+	}
+	return nums, nil
+}
+
+// ListInts reads f as comma-separated non-negative integers, each below
+// limit unless limit is negative. It returns nil and the scanner's verdict
+// on the first bad number, so nil, nil means a lenient parse skipped it.
+func (s *Scanner) ListInts(f Field, limit int, expected string) ([]int, error) {
+	nums := make([]int, 0, strings.Count(f.Text, ",")+1)
+	for _, item := range Split(f.Text, ",") {
+		n, err := strconv.Atoi(item.Text)
+		if err != nil || n < 0 || (limit >= 0 && n >= limit) {
+			if limit >= 0 {
+				expected = fmt.Sprintf("%s below %d", expected, limit)
+			}
+			return nil, s.Bad(f.Col+item.Col-1, expected, item.Text)
+		}
+		nums = append(nums, n)
+	}
+	return nums, nil
+}
+
+// Field is a piece of a line along with the 1-based column it starts at.
+type Field struct {
+	Text string
+	Col  int
+}
+
+// Split cuts s around every sep, like strings.Split, keeping each piece's
+// column. Pieces are trimmed of surrounding space.
+func Split(s, sep string) []Field {
+	var fields []Field
+	col := 1
+	for {
+		piece, rest, found := strings.Cut(s, sep)
+		trimmed := strings.TrimLeft(piece, " \t")
+		fields = append(fields, Field{
+			Text: strings.TrimRight(trimmed, " \t"),
+			Col:  col + len(piece) - len(trimmed),
+		})
+		if !found {
+			return fields
+		}
+		col += len(piece) + len(sep)
+		s = rest
+	}
+}
+
+// Fields splits s around runs of spaces, like strings.Fields, keeping each
+// field's column.
+func Fields(s string) []Field {
+	var fields []Field
+	start := -1
+	for i := 0; i <= len(s); i++ {
+		space := i == len(s) || s[i] == ' ' || s[i] == '\t'
+		switch {
+		case !space && start == -1:
+			start = i
+		case space && start != -1:
+			fields = append(fields, Field{Text: s[start:i], Col: start + 1})
+			start = -1
+		}
+	}
+	return fields
+}
+
+// Inner returns f without its first and last byte, such as the list
+// inside "(1,3)".
+func (f Field) Inner() Field {
+	if len(f.Text) < 2 {
+		return Field{Col: f.Col}
+	}
+	return Field{Text: f.Text[1 : len(f.Text)-1], Col: f.Col + 1}
+}
@@ -0,0 +1,132 @@
+package parse
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// readInts parses one integer per line the way a day's Parse would.
+func readInts(r *Source) ([]int, error) {
+	var nums []int
+	sc := NewScanner(r)
+	for sc.Scan() {
+		n, err := strconv.Atoi(sc.Text())
+		if err != nil {
+			if err := sc.BadLine("integer"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		nums = append(nums, n)
+	}
+	if len(nums) == 0 {
+		if err := sc.Missing("an integer"); err != nil {
+			return nil, err
+		}
+	}
+	return nums, sc.Err()
+}
+
+func TestScannerStrict(t *testing.T) {
+	_, err := readInts(NewSource("input.txt", strings.NewReader("1\n2\nx3\n4\n")))
+	var perr *Error
+	if !errors.As(err, &perr) {
+		t.Fatalf("err = %v, want *Error", err)
+	}
+	want := Error{File: "input.txt", Line: 3, Col: 1, Expected: "integer", Got: "x3"}
+	if *perr != want {
+		t.Errorf("err = %+v, want %+v", *perr, want)
+	}
+	if got := err.Error(); got != `input.txt:3:1: expected integer, got "x3"` {
+		t.Errorf("message = %s", got)
+	}
+}
+
+func TestScannerLenient(t *testing.T) {
+	src := NewSource("in", strings.NewReader("1\nx\n3\n\n"))
+	src.Lenient = true
+	nums, err := readInts(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nums, []int{1, 3}) {
+		t.Errorf("nums = %v, want [1 3]", nums)
+	}
+	var got []string
+	for _, w := range src.Warnings() {
+		got = append(got, w.Error())
+	}
+	want := []string{`in:2:1: expected integer, got "x"`, `in:4:1: expected integer, got end of line`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("warnings = %q, want %q", got, want)
+	}
+}
+
+func TestSourceMaxWarnings(t *testing.T) {
+	src := NewSource("in", strings.NewReader("x\n1\ny\nz\n"))
+	src.Lenient = true
+	src.MaxWarnings = 1
+	if _, err := readInts(src); err != nil {
+		t.Fatal(err)
+	}
+	if w := src.Warnings(); len(w) != 1 || w[0].Line != 1 {
+		t.Errorf("warnings = %v, want only line 1's", w)
+	}
+	if n := src.Dropped(); n != 2 {
+		t.Errorf("dropped %d warnings, want 2", n)
+	}
+}
+
+func TestScannerMissing(t *testing.T) {
+	_, err := readInts(NewSource("", strings.NewReader("")))
+	if err == nil || err.Error() != "line 1: expected an integer, got end of input" {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestSplitFields(t *testing.T) {
+	got := Split("11-22, 95 -115", ",")
+	want := []Field{{"11-22", 1}, {"95 -115", 8}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split = %v, want %v", got, want)
+	}
+	got = Fields("  aaa: bbb  ccc")
+	want = []Field{{"aaa:", 3}, {"bbb", 8}, {"ccc", 13}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fields = %v, want %v", got, want)
+	}
+	if f := (Field{"(1,3)", 4}).Inner(); f != (Field{"1,3", 5}) {
+		t.Errorf("Inner = %v", f)
+	}
+}
+
+func TestScannerInts(t *testing.T) {
+	sc := NewScanner(strings.NewReader("162,817,812\n57,618\n1,x,3\n"))
+	sc.Scan()
+	if got, err := sc.Ints(3, ",", "X,Y,Z"); err != nil || !reflect.DeepEqual(got, []int{162, 817, 812}) {
+		t.Errorf("Ints = %v, %v", got, err)
+	}
+	sc.Scan()
+	if _, err := sc.Ints(3, ",", "X,Y,Z"); err == nil || err.Error() != `line 2:1: expected X,Y,Z, got "57,618"` {
+		t.Errorf("short line: %v", err)
+	}
+	sc.Scan()
+	if _, err := sc.Ints(3, ",", "X,Y,Z"); err == nil || err.Error() != `line 3:3: expected integer, got "x"` {
+		t.Errorf("bad number: %v", err)
+	}
+}
+
+func TestScannerListInts(t *testing.T) {
+	sc := NewScanner(strings.NewReader("[.##.] (1,3) (2,9)"))
+	sc.Scan()
+	fields := Fields(sc.Text())
+	if got, err := sc.ListInts(fields[1].Inner(), 4, "light"); err != nil || !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("ListInts = %v, %v", got, err)
+	}
+	if _, err := sc.ListInts(fields[2].Inner(), 4, "light"); err == nil || err.Error() != `line 1:17: expected light below 4, got "9"` {
+		t.Errorf("out of range: %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Package progress lets a long search report how far it has got, without
+knowing who is listening. A solver starts a Tracker from its context and
+marks items done as it goes:
+
+	tr := progress.Start(ctx, "regions", len(regions))
+	defer tr.Finish()
+	for _, r := range regions {
+		...
+		tr.Done()
+	}
+
+The runner decides what to do with the events, such as drawing a status
+line on a terminal, by attaching a Sink to the context with WithSink.
+Without one, trackers cost next to nothing.
+*/
+package progress
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Kind says what an Event reports.
+type Kind int
+
+const (
+	Started  Kind = iota // the work began; Done is 0
+	ItemDone             // one more item finished
+	Finished             // the work ended, whether or not every item did
+)
+
+// Event is one step of a tracked piece of work.
+type Event struct {
+	Kind        Kind
+	Label       string // what is counted, such as "regions"
+	Done, Total int
+	Elapsed     time.Duration // since Started
+}
+
+// Fraction returns how much of the work is done, from 0 to 1.
+func (e Event) Fraction() float64 {
+	if e.Total <= 0 {
+		return 0
+	}
+	return float64(e.Done) / float64(e.Total)
+}
+
+// ETA estimates the time left from the average time per item so far. It
+// is 0 until an item is done.
+func (e Event) ETA() time.Duration {
+	if e.Done == 0 || e.Done >= e.Total {
+		return 0
+	}
+	return e.Elapsed / time.Duration(e.Done) * time.Duration(e.Total-e.Done)
+}
+
+// A Sink receives events. Trackers call it with their own lock held, so it
+// sees the events of one tracker in order, but it may be called from any
+// goroutine and must be quick.
+type Sink func(Event)
+
+type sinkKey struct{}
+
+// WithSink returns a context whose trackers send their events to sink.
+func WithSink(ctx context.Context, sink Sink) context.Context {
+	return context.WithValue(ctx, sinkKey{}, sink)
+}
+
+// Tracker counts the items of one piece of work. Its methods are safe for
+// concurrent use, so the workers of a workpool.Map can share one.
+type Tracker struct {
+	mu    sync.Mutex
+	sink  Sink
+	event Event
+	start time.Time
+}
+
+// Start begins tracking total items and sends a Started event to the
+// context's sink, if it has one.
+func Start(ctx context.Context, label string, total int) *Tracker {
+	sink, _ := ctx.Value(sinkKey{}).(Sink)
+	t := &Tracker{sink: sink, event: Event{Label: label, Total: total}}
+	if sink != nil {
+		t.start = time.Now()
+		t.send(Started)
+	}
+	return t
+}
+
+// Done marks one more item finished.
+func (t *Tracker) Done() {
+	if t.sink == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.event.Done++
+	t.send(ItemDone)
+}
+
+// Finish ends the work, even if items are left, as when a search is cut
+// short.
+func (t *Tracker) Finish() {
+	if t.sink == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.send(Finished)
+}
+
+func (t *Tracker) send(kind Kind) {
+	t.event.Kind = kind
+	t.event.Elapsed = time.Since(t.start)
+	t.sink(t.event)
+}
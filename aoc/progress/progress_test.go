@@ -0,0 +1,83 @@
+package progress
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTracker(t *testing.T) {
+	var events []Event
+	ctx := WithSink(t.Context(), func(e Event) { events = append(events, e) })
+
+	tr := Start(ctx, "regions", 2)
+	tr.Done()
+	tr.Done()
+	tr.Finish()
+
+	kinds := []Kind{Started, ItemDone, ItemDone, Finished}
+	if len(events) != len(kinds) {
+		t.Fatalf("got %d events, want %d", len(events), len(kinds))
+	}
+	for i, e := range events {
+		if e.Kind != kinds[i] || e.Label != "regions" || e.Total != 2 {
+			t.Errorf("event %d = %+v, want kind %d of 2 regions", i, e, kinds[i])
+		}
+	}
+	if done := events[2].Done; done != 2 {
+		t.Errorf("last item event has Done = %d, want 2", done)
+	}
+}
+
+func TestTrackerConcurrent(t *testing.T) {
+	var mu sync.Mutex
+	last := 0
+	ctx := WithSink(t.Context(), func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if e.Kind == ItemDone && e.Done != last+1 {
+			t.Errorf("Done went from %d to %d", last, e.Done)
+		}
+		last = e.Done
+	})
+
+	tr := Start(ctx, "items", 100)
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 25 {
+				tr.Done()
+			}
+		})
+	}
+	wg.Wait()
+	if last != 100 {
+		t.Errorf("finished at %d items, want 100", last)
+	}
+}
+
+func TestNoSink(t *testing.T) {
+	tr := Start(context.Background(), "items", 3)
+	tr.Done()
+	tr.Finish()
+}
+
+func TestETA(t *testing.T) {
+	tests := []struct {
+		e    Event
+		want time.Duration
+	}{
+		{Event{Done: 0, Total: 10, Elapsed: time.Second}, 0},
+		{Event{Done: 2, Total: 10, Elapsed: time.Second}, 4 * time.Second},
+		{Event{Done: 10, Total: 10, Elapsed: time.Second}, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.e.ETA(); got != tt.want {
+			t.Errorf("%+v.ETA() = %v, want %v", tt.e, got, tt.want)
+		}
+	}
+	if got := (Event{Done: 1, Total: 4}).Fraction(); got != 0.25 {
+		t.Errorf("Fraction = %v, want 0.25", got)
+	}
+}
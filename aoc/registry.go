@@ -0,0 +1,78 @@
+/*
+Package aoc holds the registry every puzzle solution plugs into.
+
+Each YYYY-12-DD folder is its own package that calls Register from an init
+function; the aoc command imports them all and runs whatever was registered.
+*/
+package aoc
+
+import (
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+// Solution is one day's entry in the registry.
+type Solution struct {
+	Year, Day int
+	New       func() Solver
+
+	// Samples holds the day's example inputs as samples/NAME.txt.
+	Samples fs.FS
+}
+
+type key struct {
+	year, day int
+}
+
+var registry = make(map[key]Solution)
+
+// Register adds a day's solver constructor and embedded samples directory
+// to the registry. It panics if the day is already registered, which can
+// only happen through a copy-paste mistake.
+func Register(year, day int, newSolver func() Solver, samples fs.FS) {
+	k := key{year, day}
+	if _, ok := registry[k]; ok {
+		panic(fmt.Sprintf("aoc: %d day %d registered twice", year, day))
+	}
+	registry[k] = Solution{Year: year, Day: day, New: newSolver, Samples: samples}
+}
+
+// Lookup returns the solution registered for year and day.
+func Lookup(year, day int) (Solution, bool) {
+	s, ok := registry[key{year, day}]
+	return s, ok
+}
+
+// Days returns every solution registered for year, ordered by day.
+func Days(year int) []Solution {
+	var days []Solution
+	for k, s := range registry {
+		if k.year == year {
+			days = append(days, s)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Day < days[j].Day
+	})
+	return days
+}
+
+// Years returns every year with at least one registered solution.
+func Years() []int {
+	seen := make(map[int]bool)
+	var years []int
+	for k := range registry {
+		if !seen[k.year] {
+			seen[k.year] = true
+			years = append(years, k.year)
+		}
+	}
+	sort.Ints(years)
+	return years
+}
+
+// Dir returns the folder holding a day's solution, e.g. "2025-12-01".
+func Dir(year, day int) string {
+	return fmt.Sprintf("%04d-12-%02d", year, day)
+}
@@ -0,0 +1,85 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ErrNoPuzzle is returned by a part that has no puzzle, such as the second
+// half of the last day, whose star is awarded for finishing the year.
+var ErrNoPuzzle = errors.New("aoc: no puzzle for this part")
+
+// ErrUnsolved is returned by a part that has not been solved yet.
+var ErrUnsolved = errors.New("aoc: not solved yet")
+
+// Solver is implemented by every day. Parse is called once with the puzzle
+// input; Part1 and Part2 then work from the parsed state, so a fresh Solver
+// is created for every run.
+//
+// A part whose search can run long should watch ctx and, once it is done,
+// return promptly with what it has and an error wrapping
+// context.Cause(ctx), noting in the diagnostics which items it could not
+// decide.
+type Solver interface {
+	Parse(r io.Reader) error
+	Part1(ctx context.Context) (Answer, error)
+	Part2(ctx context.Context) (Answer, error)
+}
+
+// Parallel is implemented by solvers whose parts split into independent
+// items, like day 10's machines. The runner calls SetWorkers after Parse
+// with how many goroutines the items may spread across; solvers it never
+// configures use workpool.Default.
+type Parallel interface {
+	SetWorkers(n int)
+}
+
+// ErrTraceMismatch is returned by a Replay whose trace has steps that do not
+// follow from the ones before them.
+var ErrTraceMismatch = errors.New("aoc: trace does not match the solver")
+
+// Tracer is implemented by solvers that can show their work one input line
+// at a time, like day 1's dial. Trace writes the steps taken for the parsed
+// input in format "text" or "csv". Replay is called on a fresh solver and
+// re-derives both answers from a trace either one wrote, noting any step
+// that does not follow from the one before it, so a saved or hand-edited
+// trace can be checked without the input. If any step does not follow it
+// still returns the answers and notes, with an error wrapping
+// ErrTraceMismatch.
+type Tracer interface {
+	Trace(w io.Writer, format string) error
+	Replay(r io.Reader) (part1, part2 Answer, err error)
+}
+
+// Streamer is implemented by solvers that can work through an input as it
+// arrives instead of parsing it whole, like day 1's dial. Stream reads r
+// until it ends or ctx is done, calling update with the answers so far
+// after every line it uses.
+type Streamer interface {
+	Stream(ctx context.Context, r io.Reader, update func(part1, part2 Answer)) error
+}
+
+// Answer is the result of one part of a puzzle. Diagnostics hold any
+// explanatory lines a solver wants to surface alongside the value.
+type Answer struct {
+	Value       string
+	Diagnostics []string
+}
+
+// Int returns an Answer holding an integer value.
+func Int[T ~int | ~int64](n T) Answer {
+	return Answer{Value: strconv.FormatInt(int64(n), 10)}
+}
+
+// Notef returns a copy of a with a formatted diagnostic line appended.
+func (a Answer) Notef(format string, args ...any) Answer {
+	a.Diagnostics = append(a.Diagnostics[:len(a.Diagnostics):len(a.Diagnostics)], fmt.Sprintf(format, args...))
+	return a
+}
+
+func (a Answer) String() string {
+	return a.Value
+}
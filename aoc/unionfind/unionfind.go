@@ -0,0 +1,81 @@
+/*
+Package unionfind tracks a partition of the integers 0..n-1 into disjoint
+sets, merging sets as elements are connected.
+*/
+package unionfind
+
+// UnionFind is a disjoint-set forest with path compression and union by
+// size.
+type UnionFind struct {
+	parent []int
+	size   []int
+	sets   int
+}
+
+// New returns n singleton sets.
+func New(n int) *UnionFind {
+	uf := &UnionFind{
+		parent: make([]int, n),
+		size:   make([]int, n),
+		sets:   n,
+	}
+	for i := range n {
+		uf.parent[i] = i
+		uf.size[i] = 1
+	}
+	return uf
+}
+
+// Find returns the representative of the set holding x.
+func (uf *UnionFind) Find(x int) int {
+	if uf.parent[x] != x {
+		uf.parent[x] = uf.Find(uf.parent[x])
+	}
+	return uf.parent[x]
+}
+
+// Union merges the sets holding x and y. It reports false if they were
+// already the same set.
+func (uf *UnionFind) Union(x, y int) bool {
+	rootX := uf.Find(x)
+	rootY := uf.Find(y)
+
+	if rootX == rootY {
+		return false
+	}
+
+	if uf.size[rootX] < uf.size[rootY] {
+		rootX, rootY = rootY, rootX
+	}
+
+	uf.parent[rootY] = rootX
+	uf.size[rootX] += uf.size[rootY]
+	uf.sets--
+	return true
+}
+
+// Connected reports whether x and y are in the same set.
+func (uf *UnionFind) Connected(x, y int) bool {
+	return uf.Find(x) == uf.Find(y)
+}
+
+// Size returns the number of elements in the set holding x.
+func (uf *UnionFind) Size(x int) int {
+	return uf.size[uf.Find(x)]
+}
+
+// Sets returns the number of disjoint sets.
+func (uf *UnionFind) Sets() int {
+	return uf.sets
+}
+
+// Sizes returns the size of every set, in no particular order.
+func (uf *UnionFind) Sizes() []int {
+	sizes := make([]int, 0, uf.sets)
+	for i := range uf.parent {
+		if uf.Find(i) == i {
+			sizes = append(sizes, uf.size[i])
+		}
+	}
+	return sizes
+}
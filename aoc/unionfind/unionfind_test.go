@@ -0,0 +1,31 @@
+package unionfind
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnionFind(t *testing.T) {
+	uf := New(6)
+	if !uf.Union(0, 1) || !uf.Union(1, 2) || !uf.Union(3, 4) {
+		t.Fatal("Union of separate sets reported false")
+	}
+	if uf.Union(0, 2) {
+		t.Error("Union of one set reported true")
+	}
+	if !uf.Connected(0, 2) || uf.Connected(2, 3) {
+		t.Error("Connected disagrees with the unions made")
+	}
+	if got := uf.Sets(); got != 3 {
+		t.Errorf("Sets = %d, want 3", got)
+	}
+	if got := uf.Size(2); got != 3 {
+		t.Errorf("Size(2) = %d, want 3", got)
+	}
+
+	sizes := uf.Sizes()
+	slices.Sort(sizes)
+	if !slices.Equal(sizes, []int{1, 2, 3}) {
+		t.Errorf("Sizes = %v, want [1 2 3]", sizes)
+	}
+}
@@ -0,0 +1,68 @@
+package workpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/xinyun2020/advent-of-code/aoc/progress"
+)
+
+// CheckEvery is how many steps a long search takes between looks at its
+// context: often enough to stop soon after a timeout, rarely enough to cost
+// nothing.
+const CheckEvery = 1 << 12
+
+// ErrNotStarted marks an item MapContext skipped because its context was
+// done before the item's turn came.
+var ErrNotStarted = errors.New("not started")
+
+// Outcome is what became of one item of MapContext: its value, or the
+// error that left it undecided.
+type Outcome[R any] struct {
+	Value R
+	Err   error
+}
+
+// Undecided describes an item left undecided: "unknown" if it never
+// started, "timed out" if its search was cut short, and "" if it finished.
+func (o Outcome[R]) Undecided() string {
+	switch {
+	case o.Err == nil:
+		return ""
+	case errors.Is(o.Err, ErrNotStarted):
+		return "unknown"
+	}
+	return "timed out"
+}
+
+// MapContext is Map for searches that ctx can cut short. Items whose turn
+// comes after ctx is done are not started and get ErrNotStarted; the rest
+// get whatever fn returns. Progress is tracked on ctx as label.
+func MapContext[T, R any](ctx context.Context, workers int, label string, items []T, fn func(i int, item T) (R, error)) []Outcome[R] {
+	tr := progress.Start(ctx, label, len(items))
+	defer tr.Finish()
+	return Map(workers, items, func(i int, item T) Outcome[R] {
+		if ctx.Err() != nil {
+			return Outcome[R]{Err: ErrNotStarted}
+		}
+		defer tr.Done()
+		v, err := fn(i, item)
+		return Outcome[R]{v, err}
+	})
+}
+
+// Undecided returns an error saying how many of the outcomes, counted as
+// label, were left undecided and why, or nil if all were decided.
+func Undecided[R any](ctx context.Context, label string, outcomes []Outcome[R]) error {
+	undecided := 0
+	for _, o := range outcomes {
+		if o.Err != nil {
+			undecided++
+		}
+	}
+	if undecided == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d %s undecided: %w", undecided, len(outcomes), label, context.Cause(ctx))
+}
@@ -0,0 +1,40 @@
+package workpool
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMapContext(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	timedOut := errors.New("timed out")
+	items := []int{1, 2, 3, 4}
+	// One worker takes the items in order, so the search for item 2 is
+	// cut short and items 3 and 4 never start.
+	outcomes := MapContext(ctx, 1, "items", items, func(i, n int) (int, error) {
+		if n == 2 {
+			cancel(timedOut)
+			return 0, context.Cause(ctx)
+		}
+		return n * 10, nil
+	})
+
+	want := []string{"", "timed out", "unknown", "unknown"}
+	for i, o := range outcomes {
+		if got := o.Undecided(); got != want[i] {
+			t.Errorf("item %d undecided = %q, want %q", i+1, got, want[i])
+		}
+	}
+	if outcomes[0].Value != 10 {
+		t.Errorf("item 1 = %d, want 10", outcomes[0].Value)
+	}
+
+	err := Undecided(ctx, "items", outcomes)
+	if err == nil || err.Error() != "3 of 4 items undecided: timed out" || !errors.Is(err, timedOut) {
+		t.Errorf("Undecided = %v, want 3 of 4 items undecided: timed out", err)
+	}
+	if err := Undecided(ctx, "items", outcomes[:1]); err != nil {
+		t.Errorf("Undecided with every item decided = %v, want nil", err)
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Package workpool fans independent items out across goroutines, such as the
+machines of one day or the regions of another, while keeping results in
+input order so answers and diagnostics come out the same at any -j.
+MapContext does the same for searches a context can cut short, keeping
+track of the items they left undecided.
+*/
+package workpool
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Default is the worker count for solvers the runner has not configured:
+// one per CPU.
+func Default() int {
+	return runtime.GOMAXPROCS(0)
+}
+
+// Map calls fn on every item and returns the results in item order. Up to
+// workers goroutines share the items, each taking the next unstarted one,
+// so a few slow items do not hold up the rest. With workers at most 1 fn
+// runs on the calling goroutine.
+func Map[T, R any](workers int, items []T, fn func(i int, item T) R) []R {
+	results := make([]R, len(items))
+	workers = min(workers, len(items))
+	if workers <= 1 {
+		for i, item := range items {
+			results[i] = fn(i, item)
+		}
+		return results
+	}
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for {
+				i := int(next.Add(1) - 1)
+				if i >= len(items) {
+					return
+				}
+				results[i] = fn(i, items[i])
+			}
+		})
+	}
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,48 @@
+package workpool
+
+import (
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapKeepsOrder(t *testing.T) {
+	items := []int{5, 1, 4, 2, 3, 0}
+	want := []int{25, 1, 16, 4, 9, 0}
+	for _, workers := range []int{0, 1, 3, 100} {
+		got := Map(workers, items, func(i, n int) int {
+			// Finish the early items last.
+			time.Sleep(time.Duration(len(items)-i) * time.Millisecond)
+			return n * n
+		})
+		if !slices.Equal(got, want) {
+			t.Errorf("Map with %d workers = %v, want %v", workers, got, want)
+		}
+	}
+}
+
+func TestMapUsesWorkers(t *testing.T) {
+	var running, peak atomic.Int32
+	Map(4, make([]int, 20), func(int, int) int {
+		n := running.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		running.Add(-1)
+		return 0
+	})
+	if p := peak.Load(); p < 2 || p > 4 {
+		t.Errorf("peak concurrency = %d, want between 2 and 4", p)
+	}
+}
+
+func TestMapEmpty(t *testing.T) {
+	if got := Map(4, []int(nil), func(i, n int) int { return n }); len(got) != 0 {
+		t.Errorf("Map(nil) = %v, want empty", got)
+	}
+}
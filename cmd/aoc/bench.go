@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+	"github.com/xinyun2020/advent-of-code/internal/bench"
+)
+
+// phases are the measured steps of a run, in order.
+var phases = []string{"parse", "part 1", "part 2"}
+
+// dayBench holds the fastest sample of each phase for one day; a phase the
+// day does not have is left out.
+type dayBench struct {
+	solution aoc.Solution
+	samples  map[string]bench.Sample
+}
+
+func benchCmd(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	input := flags.String("input", "", inputUsage)
+	count := flags.Int("count", 1, "run each day `n` times and keep the fastest run of each phase")
+	workers := flags.Int("j", workpool.Default(), workersUsage)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc bench [-count n] [-input spec] [-j n] <year> <day|all>")
+	}
+
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	if *input == aoc.Stdin && len(solutions) > 1 {
+		return errors.New("stdin input can only feed a single day")
+	}
+
+	var results []dayBench
+	for _, s := range solutions {
+		in, err := loadInput(s, *input)
+		if err != nil {
+			return fmt.Errorf("%d day %d: %w", s.Year, s.Day, err)
+		}
+		r, err := benchDay(s, in, *count, *workers)
+		if err != nil {
+			return fmt.Errorf("%d day %d: %w", s.Year, s.Day, err)
+		}
+		results = append(results, r)
+	}
+
+	printBench(os.Stdout, results)
+	return nil
+}
+
+// benchDay runs a day count times, each time with a fresh solver so work a
+// solver caches between parts is measured on every run.
+func benchDay(s aoc.Solution, in puzzleInput, count, workers int) (dayBench, error) {
+	r := dayBench{solution: s, samples: make(map[string]bench.Sample)}
+	keep := func(phase string, sample bench.Sample) {
+		if best, ok := r.samples[phase]; !ok || sample.Duration < best.Duration {
+			r.samples[phase] = sample
+		}
+	}
+
+	for i := 0; i < max(count, 1); i++ {
+		solver := s.New()
+		sample, err := bench.Measure(func() error {
+			return solver.Parse(in.source(false))
+		})
+		if err != nil {
+			return r, fmt.Errorf("parsing input: %w", err)
+		}
+		keep("parse", sample)
+		setWorkers(solver, workers)
+
+		for p, part := range []func(context.Context) (aoc.Answer, error){solver.Part1, solver.Part2} {
+			sample, err := bench.Measure(func() error {
+				_, err := part(context.Background())
+				return err
+			})
+			if errors.Is(err, aoc.ErrNoPuzzle) || errors.Is(err, aoc.ErrUnsolved) {
+				continue
+			}
+			if err != nil {
+				return r, fmt.Errorf("part %d: %w", p+1, err)
+			}
+			keep(phases[p+1], sample)
+		}
+	}
+	return r, nil
+}
+
+// printBench writes one row per day and phase, with each phase's share of
+// the total time so the expensive days stand out.
+func printBench(w io.Writer, results []dayBench) {
+	var total bench.Sample
+	for _, r := range results {
+		for _, s := range r.samples {
+			total = total.Add(s)
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "YEAR\tDAY\tPHASE\tTIME\tSHARE\tALLOCS\tBYTES\t")
+	for _, r := range results {
+		for _, phase := range phases {
+			s, ok := r.samples[phase]
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%d\t%s\t\n",
+				r.solution.Year, r.solution.Day, phase,
+				formatDuration(s.Duration), share(s.Duration, total.Duration), s.Allocs, bench.FormatBytes(s.Bytes))
+		}
+	}
+	fmt.Fprintf(tw, "\t\ttotal\t%s\t%s\t%d\t%s\t\n",
+		formatDuration(total.Duration), share(total.Duration, total.Duration), total.Allocs, bench.FormatBytes(total.Bytes))
+	tw.Flush()
+}
+
+func formatDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
+
+func share(d, total time.Duration) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", 100*float64(d)/float64(total))
+}
@@ -0,0 +1,20 @@
+package main
+
+// Every solution registers itself with the aoc package from init, so the
+// runner only needs to import them. New days are appended here.
+import (
+	_ "github.com/xinyun2020/advent-of-code/2023-12-01"
+	_ "github.com/xinyun2020/advent-of-code/2023-12-02"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-01"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-02"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-03"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-04"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-05"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-06"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-07"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-08"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-09"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-10"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-11"
+	_ "github.com/xinyun2020/advent-of-code/2025-12-12"
+)
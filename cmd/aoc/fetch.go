@@ -0,0 +1,120 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/internal/inputcache"
+)
+
+// cacheSpec is the input spec that reads from the input cache; "cache:USER"
+// picks an account other than the one in AOC_USER.
+const cacheSpec = "cache"
+
+func isCacheSpec(spec string) bool {
+	return spec == cacheSpec || strings.HasPrefix(spec, cacheSpec+":")
+}
+
+// openCache returns the input cache configured from the environment, with
+// the account, and that account's own token, taken from a "cache:USER" spec
+// if there is one.
+func openCache(spec string) (*inputcache.Cache, error) {
+	c, err := inputcache.FromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if user, ok := strings.CutPrefix(spec, cacheSpec+":"); ok && user != "" {
+		return c.ForUser(user)
+	}
+	return c, nil
+}
+
+// loadCached returns the cached input for s, downloading it if needed.
+func loadCached(s aoc.Solution, spec string) (puzzleInput, error) {
+	c, err := openCache(spec)
+	if err != nil {
+		return puzzleInput{}, err
+	}
+	data, err := c.Get(s.Year, s.Day)
+	return puzzleInput{name: c.Path(s.Year, s.Day), data: data}, err
+}
+
+func fetchCmd(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	user := flags.String("user", "", "account label for the cache (default $AOC_USER or \"default\")")
+	force := flags.Bool("force", false, "download again even if the input is cached")
+	save := flags.Bool("save", false, "also write the input to the day's YYYY-12-DD/input.txt")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc fetch [-user name] [-force] [-save] <year> <day|all>")
+	}
+
+	// A day can be fetched before its solution exists, so only "all" goes
+	// through the registry.
+	var days []aoc.Solution
+	if positional[1] == "all" {
+		days, err = selectDays(positional[0], positional[1])
+	} else {
+		days, err = parseDay(positional[0], positional[1])
+	}
+	if err != nil {
+		return err
+	}
+
+	spec := cacheSpec
+	if *user != "" {
+		spec += ":" + *user
+	}
+	c, err := openCache(spec)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range days {
+		get := c.Get
+		if *force {
+			get = c.Fetch
+		}
+		data, err := get(s.Year, s.Day)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%d day %d: %d bytes in %s\n", s.Year, s.Day, len(data), c.Path(s.Year, s.Day))
+
+		if *save {
+			path := filepath.Join(aoc.Dir(s.Year, s.Day), "input.txt")
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(path, data, 0o644); err != nil {
+				return err
+			}
+			fmt.Printf("%d day %d: saved to %s\n", s.Year, s.Day, path)
+		}
+	}
+	return nil
+}
+
+// parseDay turns "<year> <day>" into a bare Solution, for commands that do
+// not need the day to be registered.
+func parseDay(yearArg, dayArg string) ([]aoc.Solution, error) {
+	year, err := strconv.Atoi(yearArg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid year %q", yearArg)
+	}
+	day, err := strconv.Atoi(dayArg)
+	if err != nil || day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %q", dayArg)
+	}
+	return []aoc.Solution{{Year: year, Day: day}}, nil
+}
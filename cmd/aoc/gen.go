@@ -0,0 +1,94 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+func genCmd(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ExitOnError)
+	size := flags.Int("size", 0, "input size in the day's unit (default: about a real input)")
+	seed := flags.Uint64("seed", 0, "random seed (default: chosen from the clock and printed)")
+	out := flags.String("o", "", "write to `path` instead of stdout; {year} and {day} are expanded")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc gen [-size N] [-seed S] [-o path] <year> <day|all>")
+	}
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	if len(solutions) > 1 && (*out == "" || aoc.ExpandPath(*out, 1, 1) == aoc.ExpandPath(*out, 1, 2)) {
+		return errors.New("generating several days needs -o with {day} in the path")
+	}
+
+	if *seed == 0 {
+		*seed = uint64(time.Now().UnixNano())
+		fmt.Fprintf(os.Stderr, "seed %d\n", *seed)
+	}
+
+	for _, s := range solutions {
+		g, ok := aoc.LookupGenerator(s.Year, s.Day)
+		if !ok {
+			return fmt.Errorf("%d day %d has no generator", s.Year, s.Day)
+		}
+		n := *size
+		if n <= 0 {
+			n = g.Size
+		}
+		// Every day gets its own stream, so one seed reproduces a whole year
+		// and a single day alike.
+		rng := rand.New(rand.NewPCG(*seed, uint64(s.Year*100+s.Day)))
+
+		if *out == "" {
+			if err := generate(os.Stdout, g, n, rng); err != nil {
+				return err
+			}
+			continue
+		}
+		path := aoc.ExpandPath(*out, s.Year, s.Day)
+		if err := writeGenerated(path, g, n, rng); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "wrote %s (%d %s)\n", path, n, g.Unit)
+	}
+	return nil
+}
+
+func generate(w io.Writer, g aoc.Generator, size int, rng *rand.Rand) error {
+	bw := bufio.NewWriter(w)
+	if err := g.Generate(bw, size, rng); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func writeGenerated(path string, g aoc.Generator, size int, rng *rand.Rand) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := generate(f, g, size, rng); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,154 @@
+/*
+aoc runs the puzzle solutions registered in this repository from the repo root.
+
+	aoc run 2025 9          run both parts of day 9
+	aoc run 2025 9 -part 2  run only part 2
+	aoc run 2025 all        run every day of 2025
+
+	aoc run 2025 9 -input sample:example  run against an embedded example
+	aoc run 2025 9 -input - < input.txt   read the input from stdin
+	aoc run 2025 9 -input cache:alice     read alice's cached input
+	aoc run 2025 all -format json         machine-readable results (or csv)
+	aoc run 2025 1 -lenient               skip malformed input lines with warnings
+	aoc run 2025 12 -j 1                  solve one region at a time
+	aoc run 2025 all -timeout 30s         give up on any part after 30s
+	aoc run 2025 8 -v                     also print each part's diagnostics
+	aoc run 2025 10 -v=debug              ... and what the solver logs
+
+	aoc bench 2025 all           time and count allocations per day and part
+	aoc bench -count 5 2025 8    keep the fastest of five runs
+
+	aoc profile 2025 8           the functions each part spends its time in
+	aoc profile -mem 2025 8      ... or allocates the most memory in
+	aoc run 2025 8 -cpuprofile cpu.pprof   write cpu-2025-08-part1.pprof, ...
+
+	aoc fetch 2025 all           download missing inputs into the cache
+	aoc new 2025 13              scaffold 2025-12-13 and register it
+
+	aoc gen 2025 9 -size 40 -seed 7           a small random day 9 input
+	aoc gen 2025 all -o 'gen/{year}-{day}.txt' random inputs for every day
+
+	aoc trace 2025 1 -format csv -o trace.csv   day 1's dial, one rotation per row
+	aoc replay 2025 1 trace.csv                 answers from the trace, checked
+
+	aoc gen 2025 1 -size 1000000 | aoc stream 2025 1   running passwords
+	aoc stream -input dial.log -follow -every 1 2025 1  ... as a log grows
+
+	aoc status 2025              calendar of stars, example tests and run times
+	aoc status 2025 -format markdown -o status.md   ... as a wiki page (or html)
+
+	aoc verify 2025 all          compare every answer with answers.json
+	aoc verify -record 2025 13   also record answers for parts that have none
+
+verify never overwrites an answer already in the ledger; a wrong entry has
+to be fixed by editing answers.json.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+var commands = []command{
+	{"run", "run solutions for a day or a whole year", runCmd},
+	{"verify", "rerun solutions and compare answers with the ledger", verifyCmd},
+	{"bench", "time parsing and each part, with allocations", benchCmd},
+	{"fetch", "download puzzle inputs into the local cache", fetchCmd},
+	{"new", "scaffold the folder for a new day", newCmd},
+	{"gen", "generate random puzzle inputs for stress tests", genCmd},
+	{"profile", "show the functions a day spends its time or memory in", profileCmd},
+	{"status", "a year's calendar of stars, example tests and run times", statusCmd},
+	{"trace", "write a day's work step by step, as text or CSV", traceCmd},
+	{"replay", "re-derive a day's answers from a saved trace", replayCmd},
+	{"stream", "running answers over an input as it arrives", streamCmd},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage()
+		return
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == name {
+			if err := cmd.run(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "aoc %s: %v\n", name, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n", name)
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: aoc <command> [flags] <year> <day|all>")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", cmd.name, cmd.summary)
+	}
+}
+
+// parseArgs parses flags that may appear before, between or after the
+// positional arguments, so both "aoc run -part 1 2025 9" and
+// "aoc run 2025 9 -part 1" work. It returns the positional arguments.
+func parseArgs(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+// selectDays resolves "<year> <day|all>" to registered solutions.
+func selectDays(yearArg, dayArg string) ([]aoc.Solution, error) {
+	year, err := strconv.Atoi(yearArg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid year %q", yearArg)
+	}
+
+	if dayArg == "all" {
+		days := aoc.Days(year)
+		if len(days) == 0 {
+			return nil, fmt.Errorf("no solutions registered for %d", year)
+		}
+		return days, nil
+	}
+
+	day, err := strconv.Atoi(dayArg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day %q", dayArg)
+	}
+	s, ok := aoc.Lookup(year, day)
+	if !ok {
+		return nil, fmt.Errorf("no solution registered for %d day %d", year, day)
+	}
+	return []aoc.Solution{s}, nil
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/xinyun2020/advent-of-code/internal/scaffold"
+)
+
+func newCmd(args []string) error {
+	flags := flag.NewFlagSet("new", flag.ExitOnError)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 || positional[1] == "all" {
+		return errors.New("usage: aoc new <year> <day>")
+	}
+	days, err := parseDay(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+
+	written, err := scaffold.Create(".", days[0].Year, days[0].Day)
+	for _, path := range written {
+		fmt.Println("wrote", path)
+	}
+	return err
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strconv"
+	"strings"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+)
+
+// profileUsage documents the profile flags of run; %s names the profile.
+const profileUsage = "write a %s for each part to `path`; {year}, {day} and {part} are expanded"
+
+// profiles holds the path templates of the profiles to write around each
+// part. Empty templates are skipped.
+type profiles struct {
+	cpu, mem, trace string
+}
+
+// profilePath expands a profile path template for one part. A template
+// without {part} gets one inserted before its extension, along with the
+// year and day unless it already names the day, so profiles of different
+// parts never overwrite each other.
+func profilePath(tmpl string, year, day, part int) string {
+	if !strings.Contains(tmpl, "{part}") {
+		suffix := "-{year}-{day}-part{part}"
+		if strings.Contains(tmpl, "{day}") {
+			suffix = "-part{part}"
+		}
+		ext := filepath.Ext(tmpl)
+		tmpl = strings.TrimSuffix(tmpl, ext) + suffix + ext
+	}
+	return strings.ReplaceAll(aoc.ExpandPath(tmpl, year, day), "{part}", strconv.Itoa(part))
+}
+
+// start begins the CPU profile and execution trace for one part. The
+// returned function stops them and writes the heap profile.
+func (p profiles) start(year, day, part int) (stop func() error, err error) {
+	var stops []func() error
+	stop = func() error {
+		var errs []error
+		for _, s := range stops {
+			errs = append(errs, s())
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.cpu != "" {
+		f, err := createProfile(profilePath(p.cpu, year, day, part))
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+	if p.trace != "" {
+		f, err := createProfile(profilePath(p.trace, year, day, part))
+		if err != nil {
+			return nil, errors.Join(err, stop())
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return nil, errors.Join(err, stop())
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+	if p.mem != "" {
+		path := profilePath(p.mem, year, day, part)
+		stops = append(stops, func() error { return writeHeapProfile(path) })
+	}
+	return stop, nil
+}
+
+func createProfile(path string) (*os.File, error) {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return os.Create(path)
+}
+
+// writeHeapProfile writes the allocation profile. Its alloc_ samples
+// count every allocation since the program started, so those of a single
+// part are the difference from the profile written before it (pprof
+// -base), as aoc profile -mem does.
+func writeHeapProfile(path string) error {
+	f, err := createProfile(path)
+	if err != nil {
+		return err
+	}
+	runtime.GC() // bring the in-use numbers up to date
+	if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func profileCmd(args []string) error {
+	flags := flag.NewFlagSet("profile", flag.ExitOnError)
+	part := flags.Int("part", 0, "profile only this part (1 or 2)")
+	input := flags.String("input", "", inputUsage)
+	mem := flags.Bool("mem", false, "rank functions by bytes allocated instead of CPU time")
+	cum := flags.Bool("cum", false, "rank functions by time or bytes including their callees")
+	count := flags.Int("n", 15, "show the top `n` functions")
+	workers := flags.Int("j", workpool.Default(), workersUsage)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc profile [-part N] [-input spec] [-mem] [-cum] [-n count] [-j n] <year> <day>")
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	if len(solutions) != 1 {
+		return errors.New("profile one day at a time")
+	}
+	s := solutions[0]
+
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp("", "aoc-profile")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	in, err := loadInput(s, *input)
+	if err != nil {
+		return err
+	}
+	solver := s.New()
+	if err := solver.Parse(in.source(false)); err != nil {
+		return fmt.Errorf("parsing input: %w", err)
+	}
+	setWorkers(solver, *workers)
+
+	for i, fn := range []func(context.Context) (aoc.Answer, error){solver.Part1, solver.Part2} {
+		if *part != 0 && *part != i+1 {
+			continue
+		}
+		file := filepath.Join(dir, "part{part}.pprof")
+		p := profiles{cpu: file}
+		pprofArgs := []string{"tool", "pprof", "-top", "-nodecount=" + strconv.Itoa(*count)}
+		if *mem {
+			base := filepath.Join(dir, "base.pprof")
+			if err := writeHeapProfile(base); err != nil {
+				return err
+			}
+			p = profiles{mem: file}
+			// Writing the profiles allocates too; leave that out.
+			pprofArgs = append(pprofArgs, "-sample_index=alloc_space", "-base="+base, `-hide=^(runtime/pprof|compress/|main\.writeHeapProfile)`)
+		}
+		if *cum {
+			pprofArgs = append(pprofArgs, "-cum")
+		}
+
+		stop, err := p.start(s.Year, s.Day, i+1)
+		if err != nil {
+			return err
+		}
+		answer, partErr := fn(context.Background())
+		if err := stop(); err != nil {
+			return err
+		}
+		if errors.Is(partErr, aoc.ErrNoPuzzle) {
+			continue
+		}
+
+		fmt.Printf("== %d day %d part %d: ", s.Year, s.Day, i+1)
+		if partErr != nil {
+			fmt.Printf("error: %v\n", partErr)
+		} else {
+			fmt.Println(answer.Value)
+		}
+		cmd := exec.Command("go", append(pprofArgs, exe, profilePath(file, s.Year, s.Day, i+1))...)
+		cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("summarising the profile needs the go command: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import "testing"
+
+func TestProfilePath(t *testing.T) {
+	tests := []struct {
+		tmpl, want string
+	}{
+		{"cpu.pprof", "cpu-2025-08-part1.pprof"},
+		{"prof/{day}.cpu", "prof/08-part1.cpu"},
+		{"prof/{year}/{day}-{part}.out", "prof/2025/08-1.out"},
+		{"trace", "trace-2025-08-part1"},
+	}
+	for _, tt := range tests {
+		if got := profilePath(tt.tmpl, 2025, 8, 1); got != tt.want {
+			t.Errorf("profilePath(%q) = %q, want %q", tt.tmpl, got, tt.want)
+		}
+	}
+}
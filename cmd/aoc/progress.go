@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc/progress"
+)
+
+// redrawEvery limits how often the status line is redrawn, so a day that
+// finishes thousands of items a second does not flood the terminal.
+const redrawEvery = 100 * time.Millisecond
+
+// statusLine draws progress events as one line on a terminal, rewritten in
+// place and erased when the work finishes, so it never ends up among the
+// answers.
+type statusLine struct {
+	w       io.Writer
+	mu      sync.Mutex
+	current string    // prefix of the part being drawn
+	drawn   time.Time // when the line was last drawn; zero if erased
+}
+
+// newStatusLine returns a status line on stderr, or nil when stderr is not
+// a terminal or the answers are for a program rather than a person.
+func newStatusLine(format string) *statusLine {
+	if format != "text" || !isTerminal(os.Stderr) {
+		return nil
+	}
+	return &statusLine{w: os.Stderr}
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// start returns a progress.Sink that draws events under the given prefix,
+// such as "2025 day 12 part 1", until stop is called. A nil status line
+// returns a nil sink.
+func (s *statusLine) start(prefix string) progress.Sink {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	s.current = prefix
+	s.mu.Unlock()
+
+	return func(e progress.Event) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		// A part abandoned after a timeout may still be running; it must
+		// not draw over whatever runs next.
+		if s.current != prefix {
+			return
+		}
+
+		switch e.Kind {
+		case progress.Finished:
+			s.erase()
+			return
+		case progress.ItemDone:
+			if e.Done < e.Total && time.Since(s.drawn) < redrawEvery {
+				return
+			}
+		}
+		s.drawn = time.Now()
+
+		line := fmt.Sprintf("%s: %s %d/%d (%.0f%%)", prefix, e.Label, e.Done, e.Total, 100*e.Fraction())
+		if eta := e.ETA().Round(time.Second); eta > 0 {
+			line += fmt.Sprintf(", about %v left", eta)
+		}
+		fmt.Fprintf(s.w, "\r\033[K%s", line)
+	}
+}
+
+// stop erases the line and ignores any later events from the last start.
+func (s *statusLine) stop() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.erase()
+	s.current = ""
+}
+
+// logTo returns a writer for log lines to w that erases the status line
+// first, so the two never share a line. The line is redrawn by the next
+// progress event.
+func (s *statusLine) logTo(w io.Writer) io.Writer {
+	if s == nil {
+		return w
+	}
+	return writerFunc(func(p []byte) (int, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.erase()
+		return w.Write(p)
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) { return f(p) }
+
+func (s *statusLine) erase() {
+	if !s.drawn.IsZero() {
+		fmt.Fprint(s.w, "\r\033[K")
+		s.drawn = time.Time{}
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc/progress"
+)
+
+func TestStatusLine(t *testing.T) {
+	var out strings.Builder
+	s := &statusLine{w: &out}
+	sink := s.start("2025 day 12 part 1")
+
+	sink(progress.Event{Kind: progress.Started, Label: "regions", Total: 4})
+	sink(progress.Event{Kind: progress.ItemDone, Label: "regions", Done: 1, Total: 4, Elapsed: 3 * time.Second})
+	s.drawn = time.Time{}.Add(1) // long ago, so the next event redraws
+	sink(progress.Event{Kind: progress.ItemDone, Label: "regions", Done: 2, Total: 4, Elapsed: 4 * time.Second})
+	sink(progress.Event{Kind: progress.Finished, Label: "regions", Done: 2, Total: 4})
+
+	want := "\r\033[K2025 day 12 part 1: regions 0/4 (0%)" +
+		"\r\033[K2025 day 12 part 1: regions 2/4 (50%), about 4s left" +
+		"\r\033[K"
+	if got := out.String(); got != want {
+		t.Errorf("status line wrote %q, want %q", got, want)
+	}
+
+	// Events from a part that outlived stop are dropped.
+	s.stop()
+	out.Reset()
+	sink(progress.Event{Kind: progress.Started, Label: "regions", Total: 4})
+	if out.Len() != 0 {
+		t.Errorf("stopped status line wrote %q", out.String())
+	}
+}
+
+func TestNilStatusLine(t *testing.T) {
+	var s *statusLine
+	if sink := s.start("2025 day 12 part 1"); sink != nil {
+		t.Error("nil status line returned a sink")
+	}
+	s.stop()
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// record is one outcome of a run: a part's answer, a part not solved yet,
+// or an error from a part or from a whole day (Part 0) that could not be
+// parsed.
+type record struct {
+	Year, Day   int
+	Part        int
+	Answer      string
+	Unsolved    bool
+	Error       string
+	Duration    time.Duration
+	Input       string // ledger fingerprint of the input
+	Diagnostics []string
+}
+
+// reporter renders records as they arrive. Close finishes the output.
+type reporter interface {
+	Report(r record)
+	Close() error
+}
+
+// newReporter returns a reporter for format. verbose makes the text format
+// list every part's diagnostics, not only those of parts that failed; the
+// other formats always carry them.
+func newReporter(w io.Writer, format string, verbose bool) (reporter, error) {
+	switch format {
+	case "text":
+		return &textReporter{w: w, verbose: verbose}, nil
+	case "json":
+		return &jsonReporter{w: w}, nil
+	case "csv":
+		c := &csvReporter{w: csv.NewWriter(w)}
+		c.w.Write([]string{"year", "day", "part", "answer", "duration_ms", "input", "error", "diagnostics", "unsolved"})
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (want text, json or csv)", format)
+}
+
+// textReporter prints answers for people, with a header per day and
+// diagnostics indented under their part.
+type textReporter struct {
+	w         io.Writer
+	verbose   bool
+	year, day int
+}
+
+func (t *textReporter) Report(r record) {
+	if r.Year != t.year || r.Day != t.day {
+		t.year, t.day = r.Year, r.Day
+		fmt.Fprintf(t.w, "== %d day %d\n", r.Year, r.Day)
+	}
+
+	switch {
+	case r.Part == 0:
+		fmt.Fprintf(t.w, "error: %s\n", r.Error)
+	case r.Error != "":
+		fmt.Fprintf(t.w, "Part %d: error: %s\n", r.Part, r.Error)
+	case r.Unsolved:
+		fmt.Fprintf(t.w, "Part %d: not solved yet\n", r.Part)
+		if !t.verbose {
+			return
+		}
+	default:
+		fmt.Fprintf(t.w, "Part %d: %s\n", r.Part, r.Answer)
+		if !t.verbose {
+			return
+		}
+	}
+	for _, line := range r.Diagnostics {
+		fmt.Fprintf(t.w, "  %s\n", line)
+	}
+}
+
+func (t *textReporter) Close() error { return nil }
+
+// jsonReporter writes a JSON array, one object per record, streaming each
+// record as it arrives.
+type jsonReporter struct {
+	w     io.Writer
+	count int
+	err   error
+}
+
+type jsonRecord struct {
+	Year        int      `json:"year"`
+	Day         int      `json:"day"`
+	Part        int      `json:"part,omitempty"`
+	Answer      string   `json:"answer,omitempty"`
+	Unsolved    bool     `json:"unsolved,omitempty"`
+	DurationMS  float64  `json:"duration_ms"`
+	Input       string   `json:"input,omitempty"`
+	Error       string   `json:"error,omitempty"`
+	Diagnostics []string `json:"diagnostics,omitempty"`
+}
+
+func (j *jsonReporter) Report(r record) {
+	if j.err != nil {
+		return
+	}
+	data, err := json.Marshal(jsonRecord{
+		Year:        r.Year,
+		Day:         r.Day,
+		Part:        r.Part,
+		Answer:      r.Answer,
+		Unsolved:    r.Unsolved,
+		DurationMS:  milliseconds(r.Duration),
+		Input:       r.Input,
+		Error:       r.Error,
+		Diagnostics: r.Diagnostics,
+	})
+	if err != nil {
+		j.err = err
+		return
+	}
+
+	sep := ",\n  "
+	if j.count == 0 {
+		sep = "[\n  "
+	}
+	j.count++
+	_, j.err = fmt.Fprintf(j.w, "%s%s", sep, data)
+}
+
+func (j *jsonReporter) Close() error {
+	if j.err != nil {
+		return j.err
+	}
+	if j.count == 0 {
+		_, err := fmt.Fprintln(j.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprintln(j.w, "\n]")
+	return err
+}
+
+// csvReporter writes one row per record; diagnostics are joined with "; ".
+type csvReporter struct {
+	w *csv.Writer
+}
+
+func (c *csvReporter) Report(r record) {
+	part := ""
+	if r.Part != 0 {
+		part = strconv.Itoa(r.Part)
+	}
+	unsolved := ""
+	if r.Unsolved {
+		unsolved = "true"
+	}
+	c.w.Write([]string{
+		strconv.Itoa(r.Year),
+		strconv.Itoa(r.Day),
+		part,
+		r.Answer,
+		strconv.FormatFloat(milliseconds(r.Duration), 'f', -1, 64),
+		r.Input,
+		r.Error,
+		strings.Join(r.Diagnostics, "; "),
+		unsolved,
+	})
+	c.w.Flush()
+}
+
+func (c *csvReporter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// milliseconds converts d to fractional milliseconds, rounded to the
+// microsecond.
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Round(time.Microsecond)) / float64(time.Millisecond)
+}
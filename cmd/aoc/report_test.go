@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testRecords = []record{
+	{Year: 2025, Day: 8, Part: 1, Answer: "40", Duration: 1500 * time.Microsecond, Input: "abc", Diagnostics: []string{"Top circuit sizes: [5 4 2]", "a, b"}},
+	{Year: 2025, Day: 8, Part: 2, Error: "boom"},
+	{Year: 2025, Day: 9, Error: "open input.txt: no such file"},
+	{Year: 2025, Day: 10, Part: 1, Unsolved: true},
+}
+
+func render(t *testing.T, format string, verbose bool) string {
+	t.Helper()
+	var buf bytes.Buffer
+	rep, err := newReporter(&buf, format, verbose)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range testRecords {
+		rep.Report(r)
+	}
+	if err := rep.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestJSONReporter(t *testing.T) {
+	var got []jsonRecord
+	if err := json.Unmarshal([]byte(render(t, "json", false)), &got); err != nil {
+		t.Fatalf("output is not a JSON array: %v", err)
+	}
+	if len(got) != 4 {
+		t.Fatalf("got %d records, want 4", len(got))
+	}
+	if r := got[0]; r.Answer != "40" || r.DurationMS != 1.5 || r.Input != "abc" || len(r.Diagnostics) != 2 {
+		t.Errorf("first record = %+v", r)
+	}
+	if got[2].Part != 0 || got[2].Error == "" {
+		t.Errorf("day error record = %+v", got[2])
+	}
+	if !got[3].Unsolved || got[3].Error != "" {
+		t.Errorf("unsolved record = %+v", got[3])
+	}
+
+	var empty []jsonRecord
+	var buf bytes.Buffer
+	rep, _ := newReporter(&buf, "json", false)
+	rep.Close()
+	if err := json.Unmarshal(buf.Bytes(), &empty); err != nil || len(empty) != 0 {
+		t.Errorf("empty run = %q, want an empty array", buf.String())
+	}
+}
+
+func TestCSVReporter(t *testing.T) {
+	rows, err := csv.NewReader(strings.NewReader(render(t, "csv", false))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 5 {
+		t.Fatalf("got %d rows, want header and 4 records", len(rows))
+	}
+	want := []string{"2025", "8", "1", "40", "1.5", "abc", "", "Top circuit sizes: [5 4 2]; a, b", ""}
+	for i := range want {
+		if rows[1][i] != want[i] {
+			t.Errorf("row 1 column %s = %q, want %q", rows[0][i], rows[1][i], want[i])
+		}
+	}
+	if got := rows[4][8]; got != "true" {
+		t.Errorf("unsolved row column %s = %q, want \"true\"", rows[0][8], got)
+	}
+}
+
+func TestTextReporter(t *testing.T) {
+	want := `== 2025 day 8
+Part 1: 40
+  Top circuit sizes: [5 4 2]
+  a, b
+Part 2: error: boom
+== 2025 day 9
+error: open input.txt: no such file
+== 2025 day 10
+Part 1: not solved yet
+`
+	if got := render(t, "text", true); got != want {
+		t.Errorf("text output:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Without -v only the answers show.
+	want = `== 2025 day 8
+Part 1: 40
+Part 2: error: boom
+== 2025 day 9
+error: open input.txt: no such file
+== 2025 day 10
+Part 1: not solved yet
+`
+	if got := render(t, "text", false); got != want {
+		t.Errorf("quiet text output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUnknownFormat(t *testing.T) {
+	if _, err := newReporter(&bytes.Buffer{}, "xml", false); err == nil {
+		t.Error("newReporter accepted xml")
+	}
+}
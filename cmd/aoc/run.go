@@ -0,0 +1,279 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+	"github.com/xinyun2020/advent-of-code/aoc/progress"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+	"github.com/xinyun2020/advent-of-code/internal/ledger"
+)
+
+// inputUsage documents the -input flag shared by the commands that run solvers.
+const inputUsage = "input `spec`: a path ({year} and {day} are expanded), - for stdin, sample:NAME, or cache[:USER]"
+
+// workersUsage documents the -j flag shared by the commands that run solvers.
+const workersUsage = "spread a day's independent items across `n` goroutines (default: one per CPU)"
+
+// timeoutUsage documents the -timeout flag shared by the commands that run solvers.
+const timeoutUsage = "give up on a part after `duration`, reporting what it could not decide (0 for no limit)"
+
+// lenientUsage documents the -lenient flag shared by the commands that run solvers.
+const lenientUsage = "skip malformed input lines with a warning instead of failing"
+
+func runCmd(args []string) error {
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	part := flags.Int("part", 0, "run only this part (1 or 2)")
+	input := flags.String("input", "", inputUsage)
+	format := flags.String("format", "text", "output `format`: text, json or csv")
+	lenient := flags.Bool("lenient", false, lenientUsage)
+	workers := flags.Int("j", workpool.Default(), workersUsage)
+	timeout := flags.Duration("timeout", 0, timeoutUsage)
+	verbose := verboseFlag(flags)
+	var prof profiles
+	flags.StringVar(&prof.cpu, "cpuprofile", "", fmt.Sprintf(profileUsage, "CPU profile"))
+	flags.StringVar(&prof.mem, "memprofile", "", fmt.Sprintf(profileUsage, "heap profile"))
+	flags.StringVar(&prof.trace, "trace", "", fmt.Sprintf(profileUsage, "execution trace"))
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc run [-part N] [-input spec] [-format text|json|csv] [-lenient] [-j n] [-timeout d] [-v[=level]] [-cpuprofile path] [-memprofile path] [-trace path] <year> <day|all>")
+	}
+	if *part < 0 || *part > 2 {
+		return fmt.Errorf("invalid part %d", *part)
+	}
+	rep, err := newReporter(os.Stdout, *format, logging.Level(*verbose) >= logging.Info)
+	if err != nil {
+		return err
+	}
+	status := newStatusLine(*format)
+
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	if *input == aoc.Stdin && len(solutions) > 1 {
+		return errors.New("stdin input can only feed a single day")
+	}
+
+	failed := 0
+	for _, s := range solutions {
+		failed += runDay(rep, s, *input, runOptions{part: *part, lenient: *lenient, workers: *workers, timeout: *timeout, status: status, log: verbose.logger(status), profiles: prof})
+	}
+	if err := rep.Close(); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d failure(s)", failed)
+	}
+	return nil
+}
+
+// runDay runs the requested parts of one solution against the input named by
+// spec, reports every outcome and returns how many failed. Unsolved parts
+// are reported as such but do not count as failures.
+func runDay(rep reporter, s aoc.Solution, spec string, opts runOptions) int {
+	base := record{Year: s.Year, Day: s.Day}
+	fail := func(err error) int {
+		r := base
+		r.Error = err.Error()
+		rep.Report(r)
+		return 1
+	}
+
+	in, err := loadInput(s, spec)
+	if err != nil {
+		return fail(err)
+	}
+	base.Input = ledger.Fingerprint(in.data)
+
+	results, warnings, err := solve(s, in, opts)
+	printWarnings(os.Stderr, warnings)
+	if err != nil {
+		return fail(err)
+	}
+
+	failed := 0
+	for _, res := range results {
+		r := base
+		r.Part = res.part
+		r.Duration = res.duration
+		r.Diagnostics = res.answer.Diagnostics
+		switch {
+		case errors.Is(res.err, aoc.ErrUnsolved):
+			// A part still to be written is not a regression.
+			r.Unsolved = true
+		case res.err != nil:
+			r.Error = res.err.Error()
+			failed++
+		default:
+			r.Answer = res.answer.Value
+		}
+		rep.Report(r)
+	}
+	return failed
+}
+
+// partResult is the outcome of running one part of a solution.
+type partResult struct {
+	part     int
+	answer   aoc.Answer
+	err      error
+	duration time.Duration
+}
+
+// puzzleInput is a whole input read into memory, so it can be fingerprinted
+// as well as parsed, along with the name parse errors refer to it by.
+type puzzleInput struct {
+	name string
+	data []byte
+}
+
+// loadInput reads the input named by spec. On top of the specs
+// aoc.OpenInput understands, "cache" and "cache:USER" read from the input
+// cache, which is also the fallback for days without a checked-in input.txt.
+func loadInput(s aoc.Solution, spec string) (puzzleInput, error) {
+	if isCacheSpec(spec) {
+		return loadCached(s, spec)
+	}
+	if spec == "" {
+		_, err := os.Stat(filepath.Join(aoc.Dir(s.Year, s.Day), "input.txt"))
+		if errors.Is(err, fs.ErrNotExist) {
+			return loadCached(s, cacheSpec)
+		}
+	}
+
+	r, err := aoc.OpenInput(s, spec)
+	if err != nil {
+		return puzzleInput{}, err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	return puzzleInput{name: aoc.InputName(s, spec), data: data}, err
+}
+
+// source wraps the input for a solver's Parse, so parse errors name the
+// input and, if lenient, malformed lines become warnings.
+func (in puzzleInput) source(lenient bool) *parse.Source {
+	src := parse.NewSource(in.name, bytes.NewReader(in.data))
+	src.Lenient = lenient
+	return src
+}
+
+// runOptions are the settings shared by the commands that run solvers.
+type runOptions struct {
+	part    int // 1 or 2, or 0 for both
+	lenient bool
+	workers int
+	timeout time.Duration // per part; 0 for none
+	status  *statusLine   // where parts report progress; nil to drop it
+	log     *logging.Logger
+
+	profiles profiles
+}
+
+// solve parses the input with a fresh solver and runs the requested parts.
+// Parts without a puzzle are left out of the results. Warnings are the
+// malformed lines a lenient parse skipped.
+func solve(s aoc.Solution, in puzzleInput, opts runOptions) ([]partResult, []*parse.Error, error) {
+	solver := s.New()
+	src := in.source(opts.lenient)
+	if err := solver.Parse(src); err != nil {
+		return nil, src.Warnings(), fmt.Errorf("parsing input: %w", err)
+	}
+	setWorkers(solver, opts.workers)
+
+	var results []partResult
+	for i, fn := range []func(context.Context) (aoc.Answer, error){solver.Part1, solver.Part2} {
+		if opts.part != 0 && opts.part != i+1 {
+			continue
+		}
+		stopProfiles, err := opts.profiles.start(s.Year, s.Day, i+1)
+		if err != nil {
+			return nil, src.Warnings(), fmt.Errorf("starting profiles: %w", err)
+		}
+		prefix := fmt.Sprintf("%d day %d part %d", s.Year, s.Day, i+1)
+		ctx := logging.WithLogger(context.Background(), opts.log.With(prefix+": "))
+		if sink := opts.status.start(prefix); sink != nil {
+			ctx = progress.WithSink(ctx, sink)
+		}
+		start := time.Now()
+		answer, err := runPart(ctx, fn, opts.timeout)
+		elapsed := time.Since(start)
+		opts.status.stop()
+		if perr := stopProfiles(); perr != nil && err == nil {
+			err = fmt.Errorf("writing profiles: %w", perr)
+		}
+		if errors.Is(err, aoc.ErrNoPuzzle) {
+			continue
+		}
+		results = append(results, partResult{part: i + 1, answer: answer, err: err, duration: elapsed})
+	}
+	return results, src.Warnings(), nil
+}
+
+// abandonAfter is how long runPart waits, once a part's time is up, for it
+// to return what it has before leaving it running and moving on.
+const abandonAfter = time.Second
+
+// runPart calls a part, cancelling its context after timeout if that is
+// positive. A part that ignores the cancellation is abandoned, so one
+// runaway search cannot hold up a whole year's run.
+func runPart(ctx context.Context, part func(context.Context) (aoc.Answer, error), timeout time.Duration) (aoc.Answer, error) {
+	if timeout <= 0 {
+		return part(ctx)
+	}
+	ctx, cancel := context.WithTimeoutCause(ctx, timeout, fmt.Errorf("timed out after %v", timeout))
+	defer cancel()
+
+	type result struct {
+		answer aoc.Answer
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		answer, err := part(ctx)
+		done <- result{answer, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.answer, r.err
+	case <-ctx.Done():
+	}
+	select {
+	case r := <-done:
+		return r.answer, r.err
+	case <-time.After(abandonAfter):
+		return aoc.Answer{}, fmt.Errorf("%w, and the part did not stop", context.Cause(ctx))
+	}
+}
+
+// setWorkers passes the -j setting to solvers that split their work.
+func setWorkers(solver aoc.Solver, n int) {
+	if p, ok := solver.(aoc.Parallel); ok {
+		p.SetWorkers(max(n, 1))
+	}
+}
+
+// printWarnings lists the lines a lenient parse skipped. They go to stderr
+// so they never mix with the answers.
+func printWarnings(w io.Writer, warnings []*parse.Error) {
+	for _, warning := range warnings {
+		fmt.Fprintf(w, "warning: %v\n", warning)
+	}
+}
@@ -0,0 +1,509 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+	"github.com/xinyun2020/advent-of-code/internal/ledger"
+)
+
+// partState is what status knows about one part of one day.
+type partState int
+
+const (
+	partMissing    partState = iota // no solver, or not solved yet
+	partUnrecorded                  // answered, but not in the ledger
+	partRecorded                    // in the ledger, not rerun
+	partVerified                    // rerun, and matching the ledger
+	partChanged                     // rerun, and differing from the ledger
+	partFailed                      // rerun, and returned an error
+)
+
+// star reports whether the part has an accepted answer in the ledger.
+func (p partState) star() bool {
+	return p == partRecorded || p == partVerified || p == partChanged
+}
+
+// mark is the part's symbol in the calendar.
+func (p partState) mark() string {
+	switch p {
+	case partRecorded, partVerified:
+		return "★"
+	case partUnrecorded:
+		return "?"
+	case partChanged, partFailed:
+		return "!"
+	default:
+		return "·"
+	}
+}
+
+// testState is the outcome of a day's example tests.
+type testState int
+
+const (
+	testsNotRun testState = iota
+	testsPassed
+	testsFailed
+)
+
+func (t testState) mark() string {
+	switch t {
+	case testsPassed:
+		return "✓"
+	case testsFailed:
+		return "✗"
+	default:
+		return " "
+	}
+}
+
+// statusLegend explains the marks in every format.
+const statusLegend = "★ answer in answers.json (still matching when rerun)  ? answered but not recorded  ! changed or failed  · no solver  ✓/✗ example tests"
+
+// dayStatus is one square of the calendar.
+type dayStatus struct {
+	day     int
+	parts   [2]partState
+	tests   testState
+	runtime time.Duration // both parts, when rerun
+	err     string        // why the day could not be checked
+}
+
+// yearStatus is everything status reports for one year.
+type yearStatus struct {
+	year int
+	days []dayStatus
+}
+
+// stars counts the parts with an accepted answer, out of the year's total.
+func (y yearStatus) stars() (got, total int) {
+	for _, d := range y.days {
+		for _, p := range d.parts {
+			if p.star() {
+				got++
+			}
+		}
+	}
+	return got, 2 * len(y.days)
+}
+
+// daysIn returns how many puzzles a year has: 25 until 2024, then 12.
+func daysIn(year int) int {
+	if year >= 2025 {
+		return 12
+	}
+	return 25
+}
+
+func statusCmd(args []string) error {
+	flags := flag.NewFlagSet("status", flag.ExitOnError)
+	format := flags.String("format", "text", "output `format`: text, markdown or html")
+	out := flags.String("o", "", "write the report to `file` instead of stdout")
+	run := flags.Bool("run", true, "rerun the solvers to time them and check their answers")
+	tests := flags.Bool("tests", true, "run each day's example tests with go test")
+	input := flags.String("input", "", inputUsage)
+	ledgerPath := flags.String("ledger", "answers.json", "answer ledger `file`")
+	workers := flags.Int("j", workpool.Default(), workersUsage)
+	timeout := flags.Duration("timeout", 0, timeoutUsage)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return errors.New("usage: aoc status [-format text|markdown|html] [-o file] [-run=false] [-tests=false] [-input spec] [-ledger file] [-j n] [-timeout d] <year>")
+	}
+	year, err := strconv.Atoi(positional[0])
+	if err != nil {
+		return fmt.Errorf("invalid year %q", positional[0])
+	}
+	if *input == aoc.Stdin {
+		return errors.New("stdin input can only feed a single day")
+	}
+	if strings.HasPrefix(*input, "sample:") {
+		// A sample's answers are not the puzzle's, so they earn no stars.
+		return errors.New("status counts stars for puzzle inputs, not samples")
+	}
+	render, ok := statusRenderers[*format]
+	if !ok {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	l, err := ledger.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	y := yearStatus{year: year}
+	for day := 1; day <= daysIn(year); day++ {
+		y.days = append(y.days, dayStatus{day: day})
+	}
+
+	status := newStatusLine("text")
+	opts := runOptions{workers: *workers, timeout: *timeout, status: status}
+	for i := range y.days {
+		checkDay(&y.days[i], year, l, *input, *run, opts)
+	}
+	awardLastStar(&y)
+	if *tests {
+		if err := runExampleTests(&y); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: example tests: %v\n", err)
+		}
+	}
+
+	if *out == "" {
+		return writeStatus(os.Stdout, render, y)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := writeStatus(f, render, y); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeStatus renders y to w through a buffer, returning the first error
+// from rendering or writing.
+func writeStatus(w io.Writer, render func(io.Writer, yearStatus) error, y yearStatus) error {
+	bw := bufio.NewWriter(w)
+	if err := render(bw, y); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// checkDay fills in a day's parts from the ledger and, if run, by rerunning
+// its solver.
+func checkDay(d *dayStatus, year int, l *ledger.Ledger, spec string, run bool, opts runOptions) {
+	s, ok := aoc.Lookup(year, d.day)
+	if !ok {
+		return
+	}
+
+	in, err := loadInput(s, spec)
+	if err != nil {
+		d.err = err.Error()
+		return
+	}
+	input := ledger.Fingerprint(in.data)
+	for part := 1; part <= 2; part++ {
+		if _, ok := l.Lookup(year, d.day, input, part); ok {
+			d.parts[part-1] = partRecorded
+		}
+	}
+	if !run {
+		return
+	}
+
+	results, _, err := solve(s, in, opts)
+	if err != nil {
+		d.err = err.Error()
+		return
+	}
+	for _, r := range results {
+		d.runtime += r.duration
+		want, recorded := l.Lookup(year, d.day, input, r.part)
+		var state partState
+		switch {
+		case errors.Is(r.err, aoc.ErrUnsolved):
+			state = partMissing
+		case r.err != nil:
+			state = partFailed
+			d.err = fmt.Sprintf("part %d: %v", r.part, r.err)
+		case !recorded:
+			state = partUnrecorded
+		case r.answer.Value == want:
+			state = partVerified
+		default:
+			state = partChanged
+		}
+		d.parts[r.part-1] = state
+	}
+}
+
+// awardLastStar fills in the last day's second part, which has no puzzle:
+// its star is awarded once every other star of the year is collected.
+func awardLastStar(y *yearStatus) {
+	last := &y.days[len(y.days)-1]
+	if last.parts[1] != partMissing {
+		return
+	}
+	for _, d := range y.days {
+		for i, p := range d.parts {
+			if !p.star() && (d.day != last.day || i == 0) {
+				return
+			}
+		}
+	}
+	last.parts[1] = partRecorded
+}
+
+// testEvent is the part of a go test -json event status needs.
+type testEvent struct {
+	Action  string
+	Package string
+	Test    string
+}
+
+// runExampleTests runs the example tests (TestExamples, and TestExample*
+// where a day splits them up) in every day folder that has tests and
+// records whether they passed.
+func runExampleTests(y *yearStatus) error {
+	var pkgs []string
+	for _, d := range y.days {
+		dir := aoc.Dir(y.year, d.day)
+		if _, err := os.Stat(filepath.Join(dir, "solution_test.go")); err == nil {
+			pkgs = append(pkgs, "./"+dir)
+		}
+	}
+	if len(pkgs) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command("go", append([]string{"test", "-json", "-short", "-run", "^TestExample"}, pkgs...)...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	var exit *exec.ExitError
+	if err != nil && !errors.As(err, &exit) {
+		return err
+	}
+	results, err := parseTestEvents(strings.NewReader(string(out)))
+	if err != nil {
+		return err
+	}
+	for i := range y.days {
+		if state, ok := results[aoc.Dir(y.year, y.days[i].day)]; ok {
+			y.days[i].tests = state
+		}
+	}
+	return nil
+}
+
+// parseTestEvents reads go test -json output into the outcome of the
+// example tests per day folder: passed if every one passed. A package that
+// fails without running any, such as one that does not build, counts as
+// failed.
+func parseTestEvents(r io.Reader) (map[string]testState, error) {
+	results := make(map[string]testState)
+	dec := json.NewDecoder(r)
+	for {
+		var e testEvent
+		err := dec.Decode(&e)
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		dir := path.Base(e.Package)
+		switch {
+		case isExampleTest(e.Test) && e.Action == "pass":
+			if _, ok := results[dir]; !ok {
+				results[dir] = testsPassed
+			}
+		case isExampleTest(e.Test) && e.Action == "fail":
+			results[dir] = testsFailed
+		case e.Test == "" && e.Action == "fail":
+			if _, ok := results[dir]; !ok {
+				results[dir] = testsFailed
+			}
+		}
+	}
+}
+
+// isExampleTest reports whether test is a top-level example test; subtests
+// are covered by their parent's outcome.
+func isExampleTest(test string) bool {
+	return strings.HasPrefix(test, "TestExample") && !strings.Contains(test, "/")
+}
+
+// statusRenderers write a year's status in each -format. Write errors from
+// the text formats surface when the caller flushes; the HTML template also
+// returns its own.
+var statusRenderers = map[string]func(io.Writer, yearStatus) error{
+	"text":     renderStatusText,
+	"markdown": renderStatusMarkdown,
+	"html":     renderStatusHTML,
+}
+
+// calendar lays the year's days out in weeks from Monday to Sunday, with
+// nil for the squares before the first and after the last day.
+func calendar(y yearStatus) [][]*dayStatus {
+	offset := (int(time.Date(y.year, time.December, 1, 0, 0, 0, 0, time.UTC).Weekday()) + 6) % 7
+	var weeks [][]*dayStatus
+	for i := 0; i < offset+len(y.days); i += 7 {
+		week := make([]*dayStatus, 7)
+		for j := range week {
+			if k := i + j - offset; k >= 0 && k < len(y.days) {
+				week[j] = &y.days[k]
+			}
+		}
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+var weekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// squareMarks is a day's part and test marks, e.g. "★★ ✓".
+func squareMarks(d *dayStatus) string {
+	return d.parts[0].mark() + d.parts[1].mark() + " " + d.tests.mark()
+}
+
+// squareRuntime is a day's rerun time, or "" if it was not rerun.
+func squareRuntime(d *dayStatus) string {
+	if d.runtime == 0 {
+		return ""
+	}
+	return formatShortDuration(d.runtime)
+}
+
+// formatShortDuration rounds d to about three figures, so it fits under a
+// calendar square.
+func formatShortDuration(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(100 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(time.Millisecond).String()
+	default:
+		return d.Round(time.Microsecond).String()
+	}
+}
+
+// problems lists the days that could not be checked, for the footer.
+func problems(y yearStatus) []string {
+	var lines []string
+	for _, d := range y.days {
+		if d.err != "" {
+			lines = append(lines, fmt.Sprintf("day %d: %s", d.day, d.err))
+		}
+	}
+	return lines
+}
+
+const squareWidth = 10
+
+func renderStatusText(w io.Writer, y yearStatus) error {
+	got, total := y.stars()
+	fmt.Fprintf(w, "%d: %d/%d stars\n\n", y.year, got, total)
+	var header strings.Builder
+	for _, name := range weekdays {
+		fmt.Fprintf(&header, "%-*s", squareWidth, name)
+	}
+	fmt.Fprintln(w, strings.TrimRight(header.String(), " "))
+	for _, week := range calendar(y) {
+		var top, bottom strings.Builder
+		for _, d := range week {
+			if d == nil {
+				fmt.Fprintf(&top, "%-*s", squareWidth, "")
+				fmt.Fprintf(&bottom, "%-*s", squareWidth, "")
+				continue
+			}
+			// Padding counts runes, not bytes, so the marks line up.
+			square := fmt.Sprintf("%2d %s", d.day, squareMarks(d))
+			top.WriteString(square + strings.Repeat(" ", max(squareWidth-len([]rune(square)), 1)))
+			fmt.Fprintf(&bottom, "   %-*s", squareWidth-3, squareRuntime(d))
+		}
+		fmt.Fprintln(w, strings.TrimRight(top.String(), " "))
+		if runtimes := strings.TrimRight(bottom.String(), " "); runtimes != "" {
+			fmt.Fprintln(w, runtimes)
+		}
+	}
+	fmt.Fprintf(w, "\n%s\n", statusLegend)
+	for _, line := range problems(y) {
+		fmt.Fprintln(w, line)
+	}
+	return nil
+}
+
+func renderStatusMarkdown(w io.Writer, y yearStatus) error {
+	got, total := y.stars()
+	fmt.Fprintf(w, "## Advent of Code %d\n\n%d/%d stars\n\n", y.year, got, total)
+	fmt.Fprintf(w, "| %s |\n", strings.Join(weekdays, " | "))
+	fmt.Fprintf(w, "|%s\n", strings.Repeat("-----|", len(weekdays)))
+	for _, week := range calendar(y) {
+		fmt.Fprint(w, "|")
+		for _, d := range week {
+			if d == nil {
+				fmt.Fprint(w, " |")
+				continue
+			}
+			fmt.Fprintf(w, " **%d** %s", d.day, strings.TrimSpace(squareMarks(d)))
+			if rt := squareRuntime(d); rt != "" {
+				fmt.Fprintf(w, "<br>%s", rt)
+			}
+			fmt.Fprint(w, " |")
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "\n%s\n", statusLegend)
+	if lines := problems(y); len(lines) > 0 {
+		fmt.Fprintln(w)
+		for _, line := range lines {
+			fmt.Fprintf(w, "- %s\n", line)
+		}
+	}
+	return nil
+}
+
+var statusHTML = template.Must(template.New("status").Funcs(template.FuncMap{
+	"day":     func(d *dayStatus) int { return d.day },
+	"marks":   func(d *dayStatus) string { return strings.TrimSpace(squareMarks(d)) },
+	"runtime": squareRuntime,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Advent of Code {{.Year}}</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; min-width: 5em; vertical-align: top; }
+td small { color: #666; }
+</style>
+</head>
+<body>
+<h2>Advent of Code {{.Year}}</h2>
+<p>{{.Stars}}/{{.Total}} stars</p>
+<table>
+<tr>{{range .Weekdays}}<th>{{.}}</th>{{end}}</tr>
+{{range .Weeks}}<tr>{{range .}}{{if .}}<td><b>{{day .}}</b> {{marks .}}{{with runtime .}}<br><small>{{.}}</small>{{end}}</td>{{else}}<td></td>{{end}}{{end}}</tr>
+{{end}}</table>
+<p>{{.Legend}}</p>
+{{with .Problems}}<ul>
+{{range .}}<li>{{.}}</li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+`))
+
+func renderStatusHTML(w io.Writer, y yearStatus) error {
+	got, total := y.stars()
+	return statusHTML.Execute(w, map[string]any{
+		"Year":     y.year,
+		"Stars":    got,
+		"Total":    total,
+		"Weekdays": weekdays,
+		"Weeks":    calendar(y),
+		"Legend":   statusLegend,
+		"Problems": problems(y),
+	})
+}
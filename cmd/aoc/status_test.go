@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testYear is a 2025 status with one of each kind of square.
+func testYear() yearStatus {
+	y := yearStatus{year: 2025}
+	for day := 1; day <= daysIn(2025); day++ {
+		y.days = append(y.days, dayStatus{day: day})
+	}
+	y.days[0] = dayStatus{day: 1, parts: [2]partState{partVerified, partVerified}, tests: testsPassed, runtime: 1500 * time.Microsecond}
+	y.days[1] = dayStatus{day: 2, parts: [2]partState{partRecorded, partUnrecorded}, tests: testsFailed}
+	y.days[2] = dayStatus{day: 3, parts: [2]partState{partChanged, partFailed}, runtime: 2345 * time.Millisecond, err: "part 2: boom"}
+	return y
+}
+
+func TestCalendar(t *testing.T) {
+	tests := []struct {
+		year         int
+		offset, rows int
+	}{
+		{2025, 0, 2}, // 1 December 2025 is a Monday
+		{2023, 4, 5}, // ... and 2023's a Friday
+	}
+	for _, tt := range tests {
+		y := yearStatus{year: tt.year}
+		for day := 1; day <= daysIn(tt.year); day++ {
+			y.days = append(y.days, dayStatus{day: day})
+		}
+		weeks := calendar(y)
+		if len(weeks) != tt.rows {
+			t.Errorf("%d: %d weeks, want %d", tt.year, len(weeks), tt.rows)
+			continue
+		}
+		if d := weeks[0][tt.offset]; d == nil || d.day != 1 {
+			t.Errorf("%d: square %d of the first week is %v, want day 1", tt.year, tt.offset, d)
+		}
+		if tt.offset > 0 && weeks[0][tt.offset-1] != nil {
+			t.Errorf("%d: square before day 1 is not empty", tt.year)
+		}
+	}
+}
+
+func TestStars(t *testing.T) {
+	got, total := testYear().stars()
+	if got != 4 || total != 24 {
+		t.Errorf("stars = %d/%d, want 4/24", got, total)
+	}
+}
+
+func TestAwardLastStar(t *testing.T) {
+	y := yearStatus{year: 2025}
+	for day := 1; day <= daysIn(2025); day++ {
+		y.days = append(y.days, dayStatus{day: day, parts: [2]partState{partVerified, partVerified}})
+	}
+	y.days[11].parts[1] = partMissing
+
+	y.days[4].parts[0] = partUnrecorded
+	awardLastStar(&y)
+	if y.days[11].parts[1] != partMissing {
+		t.Errorf("last star awarded with day 5 part 1 missing")
+	}
+
+	y.days[4].parts[0] = partRecorded
+	awardLastStar(&y)
+	if y.days[11].parts[1] != partRecorded {
+		t.Errorf("last star not awarded with every other star collected")
+	}
+}
+
+func TestParseTestEvents(t *testing.T) {
+	events := `{"Action":"run","Package":"m/2025-12-01","Test":"TestExamples"}
+{"Action":"pass","Package":"m/2025-12-01","Test":"TestExamples/example"}
+{"Action":"pass","Package":"m/2025-12-01","Test":"TestExamples"}
+{"Action":"pass","Package":"m/2025-12-01"}
+{"Action":"pass","Package":"m/2025-12-12","Test":"TestExampleRegions"}
+{"Action":"fail","Package":"m/2025-12-12","Test":"TestExampleTimeout"}
+{"Action":"fail","Package":"m/2025-12-12"}
+{"Action":"fail","Package":"m/2025-12-04"}
+{"Action":"pass","Package":"m/2025-12-05","Test":"TestSomethingElse"}
+`
+	got, err := parseTestEvents(strings.NewReader(events))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]testState{
+		"2025-12-01": testsPassed,
+		"2025-12-12": testsFailed,
+		"2025-12-04": testsFailed,
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	for dir, state := range want {
+		if got[dir] != state {
+			t.Errorf("%s: %v, want %v", dir, got[dir], state)
+		}
+	}
+}
+
+func TestRenderStatusText(t *testing.T) {
+	var buf bytes.Buffer
+	renderStatusText(&buf, testYear())
+	want := `2025: 4/24 stars
+
+Mon       Tue       Wed       Thu       Fri       Sat       Sun
+ 1 ★★ ✓    2 ★? ✗    3 !!      4 ··      5 ··      6 ··      7 ··
+   2ms                 2.3s
+ 8 ··      9 ··     10 ··     11 ··     12 ··
+
+` + statusLegend + `
+day 3: part 2: boom
+`
+	if got := buf.String(); got != want {
+		t.Errorf("text status:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderStatusMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	renderStatusMarkdown(&buf, testYear())
+	out := buf.String()
+	for _, want := range []string{
+		"4/24 stars",
+		"| Mon | Tue | Wed | Thu | Fri | Sat | Sun |",
+		"| **1** ★★ ✓<br>2ms | **2** ★? ✗ | **3** !!<br>2.3s |",
+		"- day 3: part 2: boom",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown status missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderStatusHTML(t *testing.T) {
+	y := testYear()
+	y.days[2].err = "part 2: <script>"
+	var buf bytes.Buffer
+	if err := renderStatusHTML(&buf, y); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"<td><b>1</b> ★★ ✓<br><small>2ms</small></td>",
+		"<td><b>4</b> ··</td>",
+		"<li>day 3: part 2: &lt;script&gt;</li>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML status missing %q:\n%s", want, out)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestRenderStatusHTMLWriteError(t *testing.T) {
+	if err := renderStatusHTML(failingWriter{}, testYear()); err == nil {
+		t.Error("HTML status written to a failing writer returned no error")
+	}
+}
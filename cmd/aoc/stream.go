@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// followPoll is how often a followed file is checked for new lines.
+const followPoll = 200 * time.Millisecond
+
+// maxStreamWarnings is how many skipped lines a lenient stream lists; a log
+// that never ends could otherwise pile them up without limit.
+const maxStreamWarnings = 100
+
+func streamCmd(args []string) error {
+	flags := flag.NewFlagSet("stream", flag.ExitOnError)
+	input := flags.String("input", aoc.Stdin, "input `spec`: a path ({year} and {day} are expanded), - for stdin, or sample:NAME")
+	follow := flags.Bool("follow", false, "keep reading as the input file grows, like tail -f, until interrupted")
+	every := flags.Int("every", 0, "print the answers after every `n` input lines")
+	interval := flags.Duration("interval", time.Second, "print the answers every `duration` while they change (0 for never)")
+	format := flags.String("format", "text", "output `format`: text or csv")
+	lenient := flags.Bool("lenient", false, lenientUsage)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 || positional[1] == "all" {
+		return errors.New("usage: aoc stream [-input spec] [-follow] [-every n] [-interval d] [-format text|csv] [-lenient] <year> <day>")
+	}
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	s := solutions[0]
+	streamer, ok := s.New().(aoc.Streamer)
+	if !ok {
+		return fmt.Errorf("%d day %d cannot stream its input", s.Year, s.Day)
+	}
+	out, err := newStreamPrinter(os.Stdout, *format)
+	if err != nil {
+		return err
+	}
+
+	// An interrupt ends the stream like the end of the input would, with
+	// the answers so far; a second one kills the command.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	r, err := aoc.OpenInput(s, *input)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	in := io.Reader(r)
+	if *follow {
+		f, ok := r.(*os.File)
+		if !ok {
+			return errors.New("-follow needs an input file")
+		}
+		in = &follower{ctx: ctx, f: f}
+	}
+	src := parse.NewSource(aoc.InputName(s, *input), in)
+	src.Lenient = *lenient
+	src.MaxWarnings = maxStreamWarnings
+
+	var (
+		mu           sync.Mutex
+		n, printed   int
+		part1, part2 aoc.Answer
+	)
+	flush := func() {
+		if n != printed {
+			out.print(n, part1, part2)
+			printed = n
+		}
+	}
+	update := func(a1, a2 aoc.Answer) {
+		mu.Lock()
+		defer mu.Unlock()
+		n++
+		part1, part2 = a1, a2
+		if *every > 0 && n%*every == 0 {
+			flush()
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- streamer.Stream(ctx, src, update)
+	}()
+
+	var tick <-chan time.Time
+	if *interval > 0 {
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+wait:
+	for {
+		select {
+		case <-tick:
+			mu.Lock()
+			flush()
+			mu.Unlock()
+		case err = <-done:
+			break wait
+		case <-ctx.Done():
+			// A read from a pipe cannot be interrupted; report what has
+			// been counted and leave it. The source guards its warnings,
+			// so they can be read while Stream is still blocked on it.
+			break wait
+		}
+	}
+
+	mu.Lock()
+	flush()
+	mu.Unlock()
+	printWarnings(os.Stderr, src.Warnings())
+	if n := src.Dropped(); n > 0 {
+		fmt.Fprintf(os.Stderr, "warning: %d more malformed lines not listed\n", n)
+	}
+	if errors.Is(err, context.Canceled) {
+		err = nil
+	}
+	if ferr := out.close(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// follower reads a file like tail -f: at its end it waits for more to be
+// written rather than stopping, until ctx is done.
+type follower struct {
+	ctx context.Context
+	f   *os.File
+}
+
+func (f *follower) Read(p []byte) (int, error) {
+	for {
+		n, err := f.f.Read(p)
+		if n > 0 || err != io.EOF {
+			return n, err
+		}
+		select {
+		case <-f.ctx.Done():
+			return 0, io.EOF
+		case <-time.After(followPoll):
+		}
+	}
+}
+
+// streamPrinter writes the running answers of a stream.
+type streamPrinter struct {
+	w   io.Writer
+	csv *csv.Writer
+}
+
+func newStreamPrinter(w io.Writer, format string) (*streamPrinter, error) {
+	switch format {
+	case "text":
+		return &streamPrinter{w: w}, nil
+	case "csv":
+		c := csv.NewWriter(w)
+		c.Write([]string{"n", "part1", "part2"})
+		return &streamPrinter{w: w, csv: c}, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (want text or csv)", format)
+}
+
+// print writes the answers after the stream's nth update.
+func (p *streamPrinter) print(n int, part1, part2 aoc.Answer) {
+	if p.csv != nil {
+		p.csv.Write([]string{strconv.Itoa(n), part1.Value, part2.Value})
+		p.csv.Flush()
+		return
+	}
+	fmt.Fprintf(p.w, "[%d] Part 1: %s  Part 2: %s\n", n, part1.Value, part2.Value)
+}
+
+func (p *streamPrinter) close() error {
+	if p.csv != nil {
+		p.csv.Flush()
+		return p.csv.Error()
+	}
+	return nil
+}
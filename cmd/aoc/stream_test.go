@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFollower(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dial.log")
+	if err := os.WriteFile(path, []byte("L68\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	sc := bufio.NewScanner(&follower{ctx: ctx, f: f})
+	if !sc.Scan() || sc.Text() != "L68" {
+		t.Fatalf("first line = %q, want L68", sc.Text())
+	}
+
+	// A line written in two pieces after the reader caught up.
+	go func() {
+		w, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer w.Close()
+		w.WriteString("R4")
+		time.Sleep(2 * followPoll)
+		w.WriteString("8\n")
+	}()
+	if !sc.Scan() || sc.Text() != "R48" {
+		t.Fatalf("appended line = %q, want R48", sc.Text())
+	}
+
+	cancel()
+	if sc.Scan() {
+		t.Errorf("read %q after cancelling, want the end", sc.Text())
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+// tracerFor returns a fresh solver for one day, if that day can trace.
+func tracerFor(yearArg, dayArg string) (aoc.Solution, aoc.Tracer, error) {
+	if dayArg == "all" {
+		return aoc.Solution{}, nil, errors.New("traces are made one day at a time")
+	}
+	solutions, err := selectDays(yearArg, dayArg)
+	if err != nil {
+		return aoc.Solution{}, nil, err
+	}
+	s := solutions[0]
+	tracer, ok := s.New().(aoc.Tracer)
+	if !ok {
+		return s, nil, fmt.Errorf("%d day %d has no trace", s.Year, s.Day)
+	}
+	return s, tracer, nil
+}
+
+func traceCmd(args []string) error {
+	flags := flag.NewFlagSet("trace", flag.ExitOnError)
+	input := flags.String("input", "", inputUsage)
+	format := flags.String("format", "text", "trace `format`: text or csv")
+	out := flags.String("o", "", "write the trace to `file` instead of stdout")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc trace [-input spec] [-format text|csv] [-o file] <year> <day>")
+	}
+	s, tracer, err := tracerFor(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+
+	in, err := loadInput(s, *input)
+	if err != nil {
+		return err
+	}
+	if err := tracer.(aoc.Solver).Parse(in.source(false)); err != nil {
+		return fmt.Errorf("parsing input: %w", err)
+	}
+
+	if *out == "" {
+		return writeTrace(os.Stdout, tracer, *format)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := writeTrace(f, tracer, *format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeTrace writes the trace to w through a buffer, returning the first
+// error from tracing or writing.
+func writeTrace(w io.Writer, tracer aoc.Tracer, format string) error {
+	bw := bufio.NewWriter(w)
+	if err := tracer.Trace(bw, format); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func replayCmd(args []string) error {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	format := flags.String("format", "text", "output `format`: text, json or csv")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 3 {
+		return errors.New("usage: aoc replay [-format text|json|csv] <year> <day> <trace file|->")
+	}
+	s, tracer, err := tracerFor(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	rep, err := newReporter(os.Stdout, *format, true)
+	if err != nil {
+		return err
+	}
+
+	name := positional[2]
+	r := io.Reader(os.Stdin)
+	if name == aoc.Stdin {
+		name = "stdin"
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	// A trace that does not match still has answers worth showing, with
+	// the steps that are off, but fails the command.
+	part1, part2, err := tracer.Replay(parse.NewSource(name, r))
+	if err != nil && !errors.Is(err, aoc.ErrTraceMismatch) {
+		return fmt.Errorf("reading trace: %w", err)
+	}
+	for i, a := range []aoc.Answer{part1, part2} {
+		rep.Report(record{Year: s.Year, Day: s.Day, Part: i + 1, Answer: a.Value, Diagnostics: a.Diagnostics})
+	}
+	if cerr := rep.Close(); cerr != nil {
+		return cerr
+	}
+	return err
+}
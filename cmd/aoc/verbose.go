@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/xinyun2020/advent-of-code/aoc/logging"
+)
+
+// verboseUsage documents the -v flag shared by the commands that run solvers.
+const verboseUsage = "explain the answers: -v for info, or -v=debug or -v=trace for more, on stderr"
+
+// levelFlag is the -v flag: bare, it means info; otherwise it takes a
+// level name.
+type levelFlag logging.Level
+
+func (l *levelFlag) String() string { return logging.Level(*l).String() }
+
+func (l *levelFlag) Set(s string) error {
+	if s == "true" {
+		s = "info"
+	} else if s == "false" {
+		s = "quiet"
+	}
+	level, err := logging.ParseLevel(s)
+	*l = levelFlag(level)
+	return err
+}
+
+func (l *levelFlag) IsBoolFlag() bool { return true }
+
+// verboseFlag defines -v on flags.
+func verboseFlag(flags *flag.FlagSet) *levelFlag {
+	level := new(levelFlag)
+	flags.Var(level, "v", verboseUsage)
+	return level
+}
+
+// logger returns the logger solvers write to, on stderr below any status
+// line.
+func (l *levelFlag) logger(status *statusLine) *logging.Logger {
+	return logging.New(status.logTo(os.Stderr), logging.Level(*l))
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/workpool"
+	"github.com/xinyun2020/advent-of-code/internal/ledger"
+)
+
+// verifyCounts tallies the outcome of every part checked by verify.
+type verifyCounts struct {
+	ok, changed, unrecorded, recorded, failed int
+}
+
+func verifyCmd(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ExitOnError)
+	input := flags.String("input", "", inputUsage)
+	ledgerPath := flags.String("ledger", "answers.json", "answer ledger `file`")
+	record := flags.Bool("record", false, "record answers for parts that have none yet")
+	lenient := flags.Bool("lenient", false, lenientUsage)
+	workers := flags.Int("j", workpool.Default(), workersUsage)
+	timeout := flags.Duration("timeout", 0, timeoutUsage)
+	verbose := verboseFlag(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 2 {
+		return errors.New("usage: aoc verify [-record] [-input spec] [-ledger file] [-lenient] [-j n] [-timeout d] [-v[=level]] <year> <day|all>")
+	}
+
+	solutions, err := selectDays(positional[0], positional[1])
+	if err != nil {
+		return err
+	}
+	if *input == aoc.Stdin && len(solutions) > 1 {
+		return errors.New("stdin input can only feed a single day")
+	}
+
+	l, err := ledger.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	status := newStatusLine("text")
+	opts := runOptions{lenient: *lenient, workers: *workers, timeout: *timeout, status: status, log: verbose.logger(status)}
+	var counts verifyCounts
+	for _, s := range solutions {
+		verifyDay(os.Stdout, l, s, *input, *record, opts, &counts)
+	}
+
+	fmt.Printf("\n%d ok, %d changed, %d unrecorded, %d recorded, %d failed\n",
+		counts.ok, counts.changed, counts.unrecorded, counts.recorded, counts.failed)
+
+	if counts.recorded > 0 {
+		if err := l.Save(); err != nil {
+			return err
+		}
+	}
+	if counts.changed > 0 || counts.failed > 0 {
+		return fmt.Errorf("%d changed answer(s), %d failure(s)", counts.changed, counts.failed)
+	}
+	return nil
+}
+
+// verifyDay reruns one solution and compares each answer with the ledger.
+func verifyDay(w io.Writer, l *ledger.Ledger, s aoc.Solution, spec string, record bool, opts runOptions, counts *verifyCounts) {
+	in, err := loadInput(s, spec)
+	if err != nil {
+		fmt.Fprintf(w, "%d day %d: error: %v\n", s.Year, s.Day, err)
+		counts.failed++
+		return
+	}
+	input := ledger.Fingerprint(in.data)
+
+	results, warnings, err := solve(s, in, opts)
+	printWarnings(os.Stderr, warnings)
+	if err != nil {
+		fmt.Fprintf(w, "%d day %d: error: %v\n", s.Year, s.Day, err)
+		counts.failed++
+		return
+	}
+
+	for _, r := range results {
+		prefix := fmt.Sprintf("%d day %d part %d", s.Year, s.Day, r.part)
+		if errors.Is(r.err, aoc.ErrUnsolved) {
+			// Nothing to check yet, and not a regression either.
+			fmt.Fprintf(w, "%s: not solved yet\n", prefix)
+			continue
+		}
+		if r.err != nil {
+			fmt.Fprintf(w, "%s: error: %v\n", prefix, r.err)
+			counts.failed++
+			continue
+		}
+
+		got := r.answer.Value
+		want, ok := l.Lookup(s.Year, s.Day, input, r.part)
+		switch {
+		case ok && got == want:
+			fmt.Fprintf(w, "%s: ok (%s)\n", prefix, got)
+			counts.ok++
+		case ok:
+			fmt.Fprintf(w, "%s: CHANGED, recorded %s, got %s\n", prefix, want, got)
+			counts.changed++
+		case record:
+			l.Record(s.Year, s.Day, input, r.part, got)
+			fmt.Fprintf(w, "%s: recorded (%s)\n", prefix, got)
+			counts.recorded++
+		default:
+			fmt.Fprintf(w, "%s: unrecorded (%s)\n", prefix, got)
+			counts.unrecorded++
+		}
+	}
+}
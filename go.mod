@@ -0,0 +1,3 @@
+module github.com/xinyun2020/advent-of-code
+
+go 1.25
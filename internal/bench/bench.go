@@ -0,0 +1,56 @@
+/*
+Package bench measures how long a piece of work takes and how much it
+allocates, for comparing solutions across a whole year.
+*/
+package bench
+
+import (
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Sample is the cost of one run of a measured function.
+type Sample struct {
+	Duration time.Duration
+	Allocs   uint64 // heap objects allocated
+	Bytes    uint64 // heap bytes allocated
+}
+
+// Add returns the sum of two samples.
+func (s Sample) Add(o Sample) Sample {
+	return Sample{s.Duration + o.Duration, s.Allocs + o.Allocs, s.Bytes + o.Bytes}
+}
+
+// Measure runs fn once and reports its cost. A garbage collection runs
+// first so earlier work does not bleed into the measurement.
+func Measure(fn func() error) (Sample, error) {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	start := time.Now()
+	err := fn()
+	elapsed := time.Since(start)
+
+	runtime.ReadMemStats(&after)
+	return Sample{
+		Duration: elapsed,
+		Allocs:   after.Mallocs - before.Mallocs,
+		Bytes:    after.TotalAlloc - before.TotalAlloc,
+	}, err
+}
+
+// FormatBytes renders n with a binary unit, e.g. "1.5MiB".
+func FormatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return strconv.FormatUint(n, 10) + "B"
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return strconv.FormatFloat(float64(n)/float64(div), 'f', 1, 64) + string("KMGTPE"[exp]) + "iB"
+}
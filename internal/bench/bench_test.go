@@ -0,0 +1,52 @@
+package bench
+
+import (
+	"errors"
+	"testing"
+)
+
+var sink []byte
+
+func TestMeasureCountsAllocations(t *testing.T) {
+	s, err := Measure(func() error {
+		for i := 0; i < 10; i++ {
+			sink = make([]byte, 1<<20)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Allocs < 10 {
+		t.Errorf("Allocs = %d, want at least 10", s.Allocs)
+	}
+	if s.Bytes < 10<<20 {
+		t.Errorf("Bytes = %d, want at least %d", s.Bytes, 10<<20)
+	}
+}
+
+func TestMeasureReturnsError(t *testing.T) {
+	want := errors.New("boom")
+	if _, err := Measure(func() error { return want }); err != want {
+		t.Errorf("Measure error = %v, want %v", err, want)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want string
+	}{
+		{0, "0B"},
+		{1023, "1023B"},
+		{1024, "1.0KiB"},
+		{1536, "1.5KiB"},
+		{5 << 20, "5.0MiB"},
+		{3 << 30, "3.0GiB"},
+	}
+	for _, tt := range tests {
+		if got := FormatBytes(tt.n); got != tt.want {
+			t.Errorf("FormatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Package inputcache downloads puzzle inputs and keeps them in a local cache,
+so each input is fetched from adventofcode.com at most once per account.
+
+Inputs are stored as <dir>/<year>/<day>/<user>.txt. The user is just a label
+for the puzzle account the session token belongs to; it lets one cache hold
+inputs for several accounts. Each account fetches with its own token, so one
+account's input is never stored under another's name.
+*/
+package inputcache
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DefaultBaseURL is where inputs are fetched from unless configured otherwise.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// DefaultUser labels inputs when no account name is given.
+const DefaultUser = "default"
+
+// userAgent identifies the tool to the Advent of Code servers, as their
+// automation guidelines ask.
+const userAgent = "github.com/xinyun2020/advent-of-code input cache"
+
+// ErrNoSession is returned when an input has to be downloaded but there is
+// no session token to download it with.
+var ErrNoSession = errors.New("no session token")
+
+// Cache fetches and stores puzzle inputs.
+type Cache struct {
+	Dir     string // cache root
+	BaseURL string // server to fetch from, without trailing slash
+	Session string // value of the "session" cookie
+	User    string // account label used in the cache key
+	Client  *http.Client
+}
+
+// FromEnv returns a Cache configured from the environment:
+//
+//	AOC_CACHE_DIR  cache root, default <user cache dir>/aoc
+//	AOC_BASE_URL   server, default https://adventofcode.com
+//	AOC_SESSION    session cookie for AOC_USER; by default the default
+//	               account reads ~/.config/aoc/token, then the aocd tool's
+//	               ~/.config/aocd/token, and any other account only its own
+//	               token (see ForUser)
+//	AOC_USER       account label, default "default"
+func FromEnv() (*Cache, error) {
+	c := &Cache{
+		Dir:     os.Getenv("AOC_CACHE_DIR"),
+		BaseURL: os.Getenv("AOC_BASE_URL"),
+		Session: os.Getenv("AOC_SESSION"),
+		User:    os.Getenv("AOC_USER"),
+	}
+	if c.Dir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		c.Dir = filepath.Join(dir, "aoc")
+	}
+	if c.BaseURL == "" {
+		c.BaseURL = DefaultBaseURL
+	}
+	if c.User == "" {
+		c.User = DefaultUser
+	}
+	if err := checkUser(c.User); err != nil {
+		return nil, err
+	}
+	// The shared token files belong to the default account; any other
+	// account fetches with its own token or not at all.
+	switch {
+	case c.Session != "":
+	case c.User == DefaultUser:
+		c.Session = readToken("aoc/token", "aocd/token")
+	default:
+		c.Session = userToken(c.User)
+	}
+	return c, nil
+}
+
+// ForUser returns the cache as seen by another account. Its session token
+// is that account's own, from AOC_SESSION_<USER> (the name upper-cased,
+// anything but letters and digits turned into _) or
+// ~/.config/aoc/tokens/<user>; without one it can read the account's cached
+// inputs but not download any.
+func (c *Cache) ForUser(user string) (*Cache, error) {
+	if err := checkUser(user); err != nil {
+		return nil, err
+	}
+	if user == c.User {
+		return c, nil
+	}
+	u := *c
+	u.User = user
+	u.Session = userToken(user)
+	return &u, nil
+}
+
+// checkUser rejects account names that would not stay a single file name
+// inside the cache.
+func checkUser(user string) error {
+	if user == "" || user == "." || strings.Contains(user, "..") || strings.ContainsAny(user, `/\`+string(os.PathSeparator)) {
+		return fmt.Errorf("invalid account name %q", user)
+	}
+	return nil
+}
+
+// sessionEnv names the environment variable holding user's token.
+func sessionEnv(user string) string {
+	return "AOC_SESSION_" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return '_'
+	}, user)
+}
+
+// userToken returns user's own session token, or "" if it has none.
+func userToken(user string) string {
+	if token := os.Getenv(sessionEnv(user)); token != "" {
+		return token
+	}
+	return readToken("aoc/tokens/" + user)
+}
+
+// readToken returns the session token from the first of the files, relative
+// to ~/.config, that has one, or "" if none do.
+func readToken(files ...string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	for _, name := range files {
+		data, err := os.ReadFile(filepath.Join(home, ".config", filepath.FromSlash(name)))
+		if err == nil && len(strings.TrimSpace(string(data))) > 0 {
+			return strings.TrimSpace(string(data))
+		}
+	}
+	return ""
+}
+
+// Path returns where the input for year and day is cached.
+func (c *Cache) Path(year, day int) string {
+	return filepath.Join(c.Dir, fmt.Sprintf("%04d", year), fmt.Sprintf("%02d", day), c.User+".txt")
+}
+
+// Get returns the cached input for year and day, fetching it first if it
+// is not cached yet.
+func (c *Cache) Get(year, day int) ([]byte, error) {
+	if err := checkUser(c.User); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(c.Path(year, day))
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return c.Fetch(year, day)
+}
+
+// Fetch downloads the input for year and day and stores it in the cache,
+// replacing any cached copy.
+func (c *Cache) Fetch(year, day int) ([]byte, error) {
+	if err := checkUser(c.User); err != nil {
+		return nil, err
+	}
+	if c.Session == "" {
+		if c.User != DefaultUser {
+			return nil, fmt.Errorf("%w for %s: set %s or write it to ~/.config/aoc/tokens/%s", ErrNoSession, c.User, sessionEnv(c.User), c.User)
+		}
+		return nil, fmt.Errorf("%w: set AOC_SESSION or write it to ~/.config/aoc/token", ErrNoSession)
+	}
+
+	url := fmt.Sprintf("%s/%d/day/%d/input", strings.TrimSuffix(c.BaseURL, "/"), year, day)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s: %s", url, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.store(year, day, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// store writes data to the cache through a temporary file, so an
+// interrupted write never leaves a truncated input behind.
+func (c *Cache) store(year, day int, data []byte) error {
+	path := c.Path(year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".input-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,185 @@
+package inputcache
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newServer stands in for adventofcode.com. It serves an input naming the
+// requested path and session, and counts requests.
+func newServer(t *testing.T, hits *int) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*hits++
+		cookie, err := r.Cookie("session")
+		if err != nil {
+			http.Error(w, "no session", http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path == "/2025/day/99/input" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(r.URL.Path + " " + cookie.Value + "\n"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetFetchesOnceThenServesFromCache(t *testing.T) {
+	var hits int
+	srv := newServer(t, &hits)
+	c := &Cache{Dir: t.TempDir(), BaseURL: srv.URL, Session: "alice-token", User: "alice"}
+
+	for i := 0; i < 3; i++ {
+		data, err := c.Get(2025, 1)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got, want := string(data), "/2025/day/1/input alice-token\n"; got != want {
+			t.Errorf("Get = %q, want %q", got, want)
+		}
+	}
+	if hits != 1 {
+		t.Errorf("server hit %d times, want 1", hits)
+	}
+
+	want := filepath.Join(c.Dir, "2025", "01", "alice.txt")
+	if c.Path(2025, 1) != want {
+		t.Errorf("Path = %q, want %q", c.Path(2025, 1), want)
+	}
+	if _, err := os.Stat(want); err != nil {
+		t.Errorf("input not cached: %v", err)
+	}
+}
+
+func TestUsersAreCachedSeparately(t *testing.T) {
+	var hits int
+	srv := newServer(t, &hits)
+	dir := t.TempDir()
+	alice := &Cache{Dir: dir, BaseURL: srv.URL, Session: "alice-token", User: "alice"}
+	bob := &Cache{Dir: dir, BaseURL: srv.URL, Session: "bob-token", User: "bob"}
+
+	a, err := alice.Get(2025, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := bob.Get(2025, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(a) == string(b) {
+		t.Errorf("alice and bob share an input: %q", a)
+	}
+	if hits != 2 {
+		t.Errorf("server hit %d times, want 2", hits)
+	}
+}
+
+func TestFetchErrors(t *testing.T) {
+	var hits int
+	srv := newServer(t, &hits)
+
+	noSession := &Cache{Dir: t.TempDir(), BaseURL: srv.URL, User: DefaultUser}
+	if _, err := noSession.Get(2025, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Get without a session: %v, want ErrNoSession", err)
+	}
+	if hits != 0 {
+		t.Errorf("server hit %d times without a session, want 0", hits)
+	}
+
+	c := &Cache{Dir: t.TempDir(), BaseURL: srv.URL, Session: "token", User: DefaultUser}
+	if _, err := c.Get(2025, 99); err == nil {
+		t.Error("Get of a missing day succeeded")
+	}
+	if _, err := os.Stat(c.Path(2025, 99)); !os.IsNotExist(err) {
+		t.Errorf("failed fetch left a cache file: %v", err)
+	}
+}
+
+func TestForUser(t *testing.T) {
+	var hits int
+	srv := newServer(t, &hits)
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("AOC_SESSION_BOB_2", "bob-token")
+	alice := &Cache{Dir: t.TempDir(), BaseURL: srv.URL, Session: "alice-token", User: "alice"}
+
+	bob, err := alice.ForUser("bob.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := bob.Get(2025, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/2025/day/1/input bob-token\n"; string(data) != want {
+		t.Errorf("bob's input = %q, want %q", data, want)
+	}
+
+	// carol has no token of their own, and must not borrow alice's.
+	carol, err := alice.ForUser("carol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := carol.Get(2025, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Get for an account without a token: %v, want ErrNoSession", err)
+	}
+	if _, err := os.Stat(carol.Path(2025, 1)); !os.IsNotExist(err) {
+		t.Errorf("carol has a cached input: %v", err)
+	}
+	if hits != 1 {
+		t.Errorf("server hit %d times, want 1", hits)
+	}
+}
+
+func TestFromEnvKeepsTheDefaultTokenToItself(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("AOC_CACHE_DIR", t.TempDir())
+	t.Setenv("AOC_SESSION", "")
+	if err := os.MkdirAll(filepath.Join(home, ".config", "aoc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(home, ".config", "aoc", "token"), []byte("default-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("AOC_USER", "")
+	c, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Session != "default-token" {
+		t.Errorf("default account session = %q, want the token file's", c.Session)
+	}
+
+	t.Setenv("AOC_USER", "alice")
+	alice, err := FromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alice.Session != "" {
+		t.Errorf("alice borrowed session %q", alice.Session)
+	}
+	if _, err := alice.Fetch(2025, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Fetch for alice without a token: %v, want ErrNoSession", err)
+	}
+}
+
+func TestInvalidUser(t *testing.T) {
+	c := &Cache{Dir: t.TempDir(), Session: "token", User: DefaultUser}
+	for _, user := range []string{"", ".", "..", "../alice", "a/b", `a\b`} {
+		if _, err := c.ForUser(user); err == nil {
+			t.Errorf("ForUser(%q) succeeded", user)
+		}
+		bad := &Cache{Dir: c.Dir, Session: "token", User: user}
+		if _, err := bad.Get(2025, 1); err == nil || errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Get as %q: %v, want an invalid account error", user, err)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Package ledger stores answers that have been accepted on adventofcode.com.
+
+Answers are keyed by year, day and a fingerprint of the input they were
+computed from, so the same ledger can hold answers for several puzzle
+accounts and for the sample fixtures. The file is plain JSON, sorted, and
+meant to be checked in.
+*/
+package ledger
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sort"
+)
+
+// Entry holds the verified answers for one input of one day.
+type Entry struct {
+	Year  int    `json:"year"`
+	Day   int    `json:"day"`
+	Input string `json:"input"`
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+}
+
+// Ledger is the set of verified answers read from a file.
+type Ledger struct {
+	path    string
+	entries []Entry
+}
+
+// Fingerprint identifies an input by the first 16 hex digits of its SHA-256.
+func Fingerprint(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// Load reads the ledger at path. A missing file is an empty ledger, so the
+// first Save creates it.
+func Load(path string) (*Ledger, error) {
+	l := &Ledger{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &l.entries); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Lookup returns the verified answer for one part, if there is one.
+func (l *Ledger) Lookup(year, day int, input string, part int) (string, bool) {
+	e := l.find(year, day, input)
+	if e == nil {
+		return "", false
+	}
+	answer := e.answer(part)
+	return *answer, *answer != ""
+}
+
+// Record stores answer as the verified answer for one part, replacing any
+// previous one.
+func (l *Ledger) Record(year, day int, input string, part int, answer string) {
+	e := l.find(year, day, input)
+	if e == nil {
+		l.entries = append(l.entries, Entry{Year: year, Day: day, Input: input})
+		e = &l.entries[len(l.entries)-1]
+	}
+	*e.answer(part) = answer
+}
+
+// Save writes the ledger back to its file, sorted so diffs stay small.
+func (l *Ledger) Save() error {
+	sort.Slice(l.entries, func(i, j int) bool {
+		a, b := l.entries[i], l.entries[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Input < b.Input
+	})
+
+	data, err := json.MarshalIndent(l.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(l.path, append(data, '\n'), 0o644)
+}
+
+func (l *Ledger) find(year, day int, input string) *Entry {
+	for i := range l.entries {
+		e := &l.entries[i]
+		if e.Year == year && e.Day == day && e.Input == input {
+			return e
+		}
+	}
+	return nil
+}
+
+func (e *Entry) answer(part int) *string {
+	if part == 1 {
+		return &e.Part1
+	}
+	return &e.Part2
+}
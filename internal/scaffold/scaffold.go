@@ -0,0 +1,225 @@
+/*
+Package scaffold creates the folder for a new day: a solver skeleton that
+implements aoc.Solver, a test with slots for the puzzle's example answers,
+an empty example fixture, and the import that registers the day with the
+aoc command.
+*/
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+)
+
+// DaysFile is the file, relative to the repo root, whose imports register
+// every day with the aoc command.
+const DaysFile = "cmd/aoc/days.go"
+
+var solutionTmpl = template.Must(template.New("solution").Parse(`/*
+Day {{.Day}}:
+
+Part 1:
+Part 2:
+*/
+package {{.Package}}
+
+import (
+	"context"
+	"embed"
+	"io"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/parse"
+)
+
+//go:embed samples
+var samples embed.FS
+
+type solver struct {
+	lines []string
+}
+
+func init() {
+	aoc.Register({{.Year}}, {{.Day}}, func() aoc.Solver { return &solver{} }, samples)
+}
+
+func (s *solver) Parse(r io.Reader) error {
+	sc := parse.NewScanner(r)
+	for sc.Scan() {
+		// Report malformed lines with sc.Bad, so strict runs stop at them
+		// and lenient runs skip them with a warning.
+		s.lines = append(s.lines, sc.Text())
+	}
+	return sc.Err()
+}
+
+// Part1 and Part2 should return once ctx is done, with whatever they
+// have and context.Cause(ctx), so a -timeout cuts a long search short.
+func (s *solver) Part1(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrUnsolved
+}
+
+func (s *solver) Part2(ctx context.Context) (aoc.Answer, error) {
+	return aoc.Answer{}, aoc.ErrUnsolved
+}
+`))
+
+var testTmpl = template.Must(template.New("test").Parse(`package {{.Package}}
+
+import (
+	"testing"
+
+	"github.com/xinyun2020/advent-of-code/aoc"
+	"github.com/xinyun2020/advent-of-code/aoc/aoctest"
+)
+
+func TestExamples(t *testing.T) {
+	// Paste the puzzle's example into samples/example.txt and fill in the
+	// answers from the puzzle text.
+	aoctest.Run(t, func() aoc.Solver { return &solver{} }, []aoctest.Case{
+		{Name: "example", Part1: "", Part2: ""},
+	})
+}
+`))
+
+type dayData struct {
+	Year, Day int
+	Package   string
+}
+
+// Create scaffolds year/day under the repo at root and returns the paths it
+// wrote. It refuses to touch a day that already has a solution.
+func Create(root string, year, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %d", day)
+	}
+	d := dayData{Year: year, Day: day, Package: fmt.Sprintf("day%02d", day)}
+	dir := filepath.Join(root, aoc.Dir(year, day))
+
+	solution := filepath.Join(dir, "solution.go")
+	if _, err := os.Stat(solution); err == nil {
+		return nil, fmt.Errorf("%s already exists", solution)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "samples"), 0o755); err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for _, f := range []struct {
+		path string
+		tmpl *template.Template
+	}{
+		{solution, solutionTmpl},
+		{filepath.Join(dir, "solution_test.go"), testTmpl},
+	} {
+		if err := writeTemplate(f.path, f.tmpl, d); err != nil {
+			return written, err
+		}
+		written = append(written, f.path)
+	}
+
+	example := filepath.Join(dir, "samples", "example.txt")
+	if _, err := os.Stat(example); errors.Is(err, fs.ErrNotExist) {
+		if err := os.WriteFile(example, nil, 0o644); err != nil {
+			return written, err
+		}
+		written = append(written, example)
+	}
+
+	module, err := modulePath(root)
+	if err != nil {
+		return written, err
+	}
+	daysFile := filepath.Join(root, DaysFile)
+	if err := addImport(daysFile, module+"/"+aoc.Dir(year, day)); err != nil {
+		return written, err
+	}
+	return append(written, daysFile), nil
+}
+
+func writeTemplate(path string, tmpl *template.Template, d dayData) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// modulePath reads the module path from root's go.mod.
+func modulePath(root string) (string, error) {
+	f, err := os.Open(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.TrimSpace(path), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", errors.New("go.mod has no module line")
+}
+
+// addImport adds a blank import of pkg to the import block in path, keeping
+// the block sorted. It does nothing if the import is already there.
+func addImport(path, pkg string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	src := string(data)
+
+	start := strings.Index(src, "import (\n")
+	if start == -1 {
+		return fmt.Errorf("%s has no import block", path)
+	}
+	start += len("import (\n")
+	end := strings.Index(src[start:], ")")
+	if end == -1 {
+		return fmt.Errorf("%s has an unterminated import block", path)
+	}
+	end += start
+
+	line := fmt.Sprintf("\t_ %q", pkg)
+	var lines []string
+	for _, l := range strings.Split(strings.TrimSuffix(src[start:end], "\n"), "\n") {
+		if l == line {
+			return nil
+		}
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	lines = append(lines, line)
+	sort.Strings(lines)
+
+	out := src[:start] + strings.Join(lines, "\n") + "\n" + src[end:]
+	formatted, err := format.Source([]byte(out))
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
@@ -0,0 +1,106 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testDays = `package main
+
+// Every solution registers itself from init.
+import (
+	_ "example.com/aoc/2025-12-01"
+	_ "example.com/aoc/2025-12-12"
+)
+`
+
+// newRepo lays out the parts of a repo that Create touches.
+func newRepo(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "cmd", "aoc"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, DaysFile), []byte(testDays), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestCreate(t *testing.T) {
+	root := newRepo(t)
+
+	written, err := Create(root, 2025, 7)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if len(written) != 4 {
+		t.Errorf("wrote %v, want solution, test, sample and days file", written)
+	}
+
+	solution, err := os.ReadFile(filepath.Join(root, "2025-12-07", "solution.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"package day07", "aoc.Register(2025, 7,", "//go:embed samples"} {
+		if !strings.Contains(string(solution), want) {
+			t.Errorf("solution.go is missing %q", want)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(root, "2025-12-07", "samples", "example.txt")); err != nil {
+		t.Errorf("example fixture: %v", err)
+	}
+
+	days, err := os.ReadFile(filepath.Join(root, DaysFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `import (
+	_ "example.com/aoc/2025-12-01"
+	_ "example.com/aoc/2025-12-07"
+	_ "example.com/aoc/2025-12-12"
+)`
+	if !strings.Contains(string(days), want) {
+		t.Errorf("days.go imports not updated in order:\n%s", days)
+	}
+}
+
+func TestCreateRefusesExistingDay(t *testing.T) {
+	root := newRepo(t)
+	if _, err := Create(root, 2025, 7); err != nil {
+		t.Fatal(err)
+	}
+
+	// Simulate work in progress that must not be overwritten.
+	path := filepath.Join(root, "2025-12-07", "solution.go")
+	if err := os.WriteFile(path, []byte("package day07 // solved\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Create(root, 2025, 7); err == nil {
+		t.Error("Create over an existing day succeeded")
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "package day07 // solved\n" {
+		t.Errorf("existing solution overwritten: %q", data)
+	}
+}
+
+func TestAddImportIsIdempotent(t *testing.T) {
+	root := newRepo(t)
+	path := filepath.Join(root, DaysFile)
+	for i := 0; i < 2; i++ {
+		if err := addImport(path, "example.com/aoc/2025-12-01"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != testDays {
+		t.Errorf("days.go changed:\n%s", data)
+	}
+}